@@ -0,0 +1,128 @@
+package api
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrSlowConsumer is reported by a Subscription that was dropped because its
+// buffer filled up faster than the subscriber drained it.
+var ErrSlowConsumer = errors.New("subscriber too slow, dropped from broker")
+
+type topic struct {
+	vendor      string
+	productType string
+}
+
+// Broker is an in-process pub/sub hub delivering every published product to
+// all subscriptions on the product's vendor and product type.
+//
+// Publishing never blocks: each subscription owns a buffer of bufSize
+// products and a subscriber whose buffer is full when a product is published
+// is dropped from the broker, its channel is closed and Err reports
+// ErrSlowConsumer. Subscribers are expected to resubscribe and resync from the
+// store.
+type Broker struct {
+	mu      sync.Mutex
+	bufSize int
+	subs    map[topic]map[*Subscription]struct{}
+}
+
+// NewBroker returns a Broker giving each subscription a buffer of bufSize
+// products.
+func NewBroker(bufSize int) *Broker {
+	return &Broker{
+		bufSize: bufSize,
+		subs:    make(map[topic]map[*Subscription]struct{}),
+	}
+}
+
+// Subscription receives the products published on one vendor and product type.
+type Subscription struct {
+	// C delivers the published products. It is closed once the subscription
+	// is cancelled or dropped.
+	C <-chan Product
+
+	c      chan Product
+	broker *Broker
+	topic  topic
+	err    error
+}
+
+// Subscribe registers a new subscription for products of vendor under
+// productType. Callers must Cancel it when done.
+func (b *Broker) Subscribe(vendor, productType string) *Subscription {
+	c := make(chan Product, b.bufSize)
+	sub := &Subscription{
+		C:      c,
+		c:      c,
+		broker: b,
+		topic:  topic{vendor: vendor, productType: productType},
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.subs[sub.topic] == nil {
+		b.subs[sub.topic] = make(map[*Subscription]struct{})
+	}
+	b.subs[sub.topic][sub] = struct{}{}
+	return sub
+}
+
+// Publish delivers product to every subscription on its vendor and product
+// type, dropping the ones whose buffer is full.
+func (b *Broker) Publish(product Product) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.subs[topic{vendor: product.Vendor, productType: product.ProductType}] {
+		select {
+		case sub.c <- product:
+		default:
+			b.remove(sub, ErrSlowConsumer)
+		}
+	}
+}
+
+// Subscribers returns the number of live subscriptions.
+func (b *Broker) Subscribers() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	n := 0
+	for _, subs := range b.subs {
+		n += len(subs)
+	}
+	return n
+}
+
+// remove must be called with b.mu held.
+func (b *Broker) remove(sub *Subscription, err error) {
+	subs, found := b.subs[sub.topic]
+	if !found {
+		return
+	}
+	if _, found := subs[sub]; !found {
+		return
+	}
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(b.subs, sub.topic)
+	}
+	sub.err = err
+	close(sub.c)
+}
+
+// Cancel unregisters the subscription and closes C. It is safe to call more
+// than once.
+func (s *Subscription) Cancel() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+	s.broker.remove(s, nil)
+}
+
+// Err returns ErrSlowConsumer if the subscription was dropped by the broker
+// and nil otherwise.
+func (s *Subscription) Err() error {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+	return s.err
+}
@@ -0,0 +1,354 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/bharat-rajani/grpc-products-demo/gen/proto/products"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// chatBuffer is the number of messages a chat member may fall behind by
+// before the hub drops it.
+const chatBuffer = 64
+
+// ErrSlowChatMember is reported by a chat member that was dropped because its
+// buffer filled up faster than its stream drained it.
+var ErrSlowChatMember = errors.New("chat member too slow, dropped from room")
+
+// chatHub fans the events posted in a vendor room out to every member of the
+// room, keeps the text messages in the history and tracks who is connected.
+// Like the Broker, posting never blocks and members whose buffer is full are
+// dropped.
+type chatHub struct {
+	mu       sync.Mutex
+	bufSize  int
+	rooms    map[string]map[*chatMember]struct{}
+	presence map[string]map[string]*participant
+	history  *ChatHistory
+}
+
+// participant is a sender connected to a room through one or more streams.
+type participant struct {
+	joinedAt    time.Time
+	connections int
+}
+
+func newChatHub(bufSize int, history *ChatHistory) *chatHub {
+	return &chatHub{
+		bufSize:  bufSize,
+		rooms:    make(map[string]map[*chatMember]struct{}),
+		presence: make(map[string]map[string]*participant),
+		history:  history,
+	}
+}
+
+// chatMember is one ChatVendorSales stream connected to a room.
+type chatMember struct {
+	// C delivers the events posted in the room. It is closed once the
+	// member leaves or is dropped.
+	C <-chan *pb.ChatMessage
+
+	c      chan *pb.ChatMessage
+	hub    *chatHub
+	room   string
+	sender string
+	err    error
+	left   bool
+}
+
+// newChatEvent returns an event of sender in room, stamped with a new id and
+// the current time.
+func newChatEvent(room, sender string) *pb.ChatMessage {
+	return &pb.ChatMessage{
+		Sender:    sender,
+		Room:      room,
+		Timestamp: timestamppb.Now(),
+		Id:        uuid.Must(uuid.NewRandom()).String(),
+	}
+}
+
+// join adds sender to room and returns the history messages the first message
+// of the member asked to replay, which precede everything delivered to C.
+// The room is told when sender connects for the first time. Callers must
+// leave when done.
+func (h *chatHub) join(room, sender string, first *pb.ChatMessage) (*chatMember, []*pb.ChatMessage) {
+	c := make(chan *pb.ChatMessage, h.bufSize)
+	member := &chatMember{C: c, c: c, hub: h, room: room, sender: sender}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.rooms[room] == nil {
+		h.rooms[room] = make(map[*chatMember]struct{})
+		h.presence[room] = make(map[string]*participant)
+	}
+	h.rooms[room][member] = struct{}{}
+	backlog := h.history.since(room, first.GetReplaySinceId(), first.GetReplaySince())
+
+	p, found := h.presence[room][sender]
+	if !found {
+		p = &participant{joinedAt: time.Now()}
+		h.presence[room][sender] = p
+	}
+	p.connections++
+	if !found {
+		event := newChatEvent(room, sender)
+		event.Event = &pb.ChatMessage_Join{Join: &pb.ChatJoin{}}
+		h.deliver(event)
+	}
+	return member, backlog
+}
+
+// post delivers msg to every member of its room, keeping text messages in the
+// history.
+func (h *chatHub) post(msg *pb.ChatMessage) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, text := msg.GetEvent().(*pb.ChatMessage_MessageContent); text {
+		if err := h.history.add(msg); err != nil {
+			log.Printf("could not keep chat message %s: %v", msg.GetId(), err)
+		}
+	}
+	h.deliver(msg)
+}
+
+// deliver must be called with h.mu held. Members whose buffer is full are
+// dropped.
+func (h *chatHub) deliver(msg *pb.ChatMessage) {
+	for member := range h.rooms[msg.GetRoom()] {
+		select {
+		case member.c <- msg:
+		default:
+			h.remove(member, ErrSlowChatMember)
+		}
+	}
+}
+
+// remove must be called with h.mu held. The member stays a participant of
+// the room until it leaves.
+func (h *chatHub) remove(member *chatMember, err error) {
+	members := h.rooms[member.room]
+	if _, found := members[member]; !found {
+		return
+	}
+	delete(members, member)
+	member.err = err
+	close(member.c)
+}
+
+// notify delivers msg to member alone, dropping the member if its buffer is
+// full.
+func (h *chatHub) notify(member *chatMember, msg *pb.ChatMessage) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, found := h.rooms[member.room][member]; !found {
+		return
+	}
+	select {
+	case member.c <- msg:
+	default:
+		h.remove(member, ErrSlowChatMember)
+	}
+}
+
+// kick removes every member of room connected as sender.
+func (h *chatHub) kick(room, sender string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for member := range h.rooms[room] {
+		if member.sender == sender {
+			h.remove(member, ErrKickedChatMember)
+		}
+	}
+}
+
+// participants returns the participants of room, or of every room if room
+// is empty, sorted by room and name.
+func (h *chatHub) participants(room string) []*pb.RoomParticipants {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var rooms []*pb.RoomParticipants
+	for name, present := range h.presence {
+		if room != "" && name != room {
+			continue
+		}
+		participants := &pb.RoomParticipants{Room: name}
+		for sender, p := range present {
+			participants.Participants = append(participants.Participants, &pb.ChatParticipant{
+				Name:        sender,
+				JoinedAt:    timestamppb.New(p.joinedAt),
+				Connections: int32(p.connections),
+			})
+		}
+		sort.Slice(participants.Participants, func(i, j int) bool {
+			return participants.Participants[i].Name < participants.Participants[j].Name
+		})
+		rooms = append(rooms, participants)
+	}
+	sort.Slice(rooms, func(i, j int) bool { return rooms[i].Room < rooms[j].Room })
+	return rooms
+}
+
+// leave removes the member from its room and closes C. The room is told when
+// the last connection of the sender leaves. It is safe to call more than
+// once.
+func (m *chatMember) leave() {
+	h := m.hub
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.remove(m, nil)
+	if m.left {
+		return
+	}
+	m.left = true
+
+	p := h.presence[m.room][m.sender]
+	p.connections--
+	if p.connections > 0 {
+		return
+	}
+	delete(h.presence[m.room], m.sender)
+	if len(h.presence[m.room]) == 0 {
+		delete(h.presence, m.room)
+		delete(h.rooms, m.room)
+	}
+	event := newChatEvent(m.room, m.sender)
+	event.Event = &pb.ChatMessage_Leave{Leave: &pb.ChatLeave{}}
+	h.deliver(event)
+}
+
+// Err returns ErrSlowChatMember or ErrKickedChatMember if the member was
+// dropped by the hub and nil otherwise.
+func (m *chatMember) Err() error {
+	m.hub.mu.Lock()
+	defer m.hub.mu.Unlock()
+	return m.err
+}
+
+// ChatVendorSales connects the stream to the chat room of a vendor. The first
+// message names the room and the sender, may ask to replay the history and is
+// posted if it carries an event; every later message is posted to the same
+// room as the same sender. Members receive the replayed history, then every
+// event posted in the room, their own included, stamped with an id and the
+// time the server received it. Text messages starting with a slash are bot
+// commands, answered in the room by ChatBotName. Messages breaking the
+// ChatRules are not posted; their sender alone is told why.
+func (pserv *ProductServer) ChatVendorSales(stream pb.ProductService_ChatVendorSalesServer) error {
+	ctx := stream.Context()
+
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	room, sender := first.GetRoom(), strings.TrimSpace(first.GetSender())
+	if sender == "" {
+		return status.Error(codes.InvalidArgument, "the first chat message must name its sender")
+	}
+	if sender == ChatBotName {
+		return status.Errorf(codes.InvalidArgument, "%s is reserved for the chat bot", ChatBotName)
+	}
+	if err := pserv.checkVendor(room); err != nil {
+		return err
+	}
+	if until, kicked := pserv.moderator.kicked(room, sender); kicked {
+		return status.Errorf(codes.PermissionDenied, "%s was kicked from the %s chat room until %s", sender, room, until.Format(time.RFC3339))
+	}
+
+	log.Printf("-> %s <- has joined the -> %s <- chat room", sender, room)
+	member, backlog := pserv.chat.join(room, sender, first)
+	defer func() {
+		member.leave()
+		log.Printf("-> %s <- has left the -> %s <- chat room", sender, room)
+	}()
+	for _, msg := range backlog {
+		if err := stream.Send(msg); err != nil {
+			return err
+		}
+	}
+
+	// the receiving goroutine ends with the stream, which is cancelled as
+	// soon as this handler returns
+	received := make(chan error, 1)
+	go func() {
+		msg := first
+		for {
+			if isClientEvent(msg) {
+				posted := newChatEvent(room, sender)
+				posted.Event = msg.Event
+				if reason, ok := pserv.moderator.check(posted); !ok {
+					if reason != "" {
+						log.Printf("-> %s <- message rejected in the -> %s <- chat room: %s", sender, room, reason)
+						pserv.chat.notify(member, rejection(posted, reason))
+					}
+				} else {
+					pserv.chat.post(posted)
+					if reply, ok := pserv.bot.answer(ctx, posted); ok {
+						pserv.chat.post(reply)
+					}
+				}
+			}
+			var err error
+			if msg, err = stream.Recv(); err != nil {
+				received <- err
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return contextError(ctx.Err())
+
+		case err := <-received:
+			if err == io.EOF {
+				return nil
+			}
+			return err
+
+		case msg, ok := <-member.C:
+			if !ok {
+				log.Printf("chat member dropped: %v", member.Err())
+				if member.Err() == ErrKickedChatMember {
+					return status.Errorf(codes.PermissionDenied, "%s was kicked from the %s chat room", sender, room)
+				}
+				return status.Error(codes.ResourceExhausted, "Client too slow to receive chat messages, stopping...")
+			}
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// isClientEvent reports whether msg carries an event a client may post: empty
+// texts and receipts are dropped, and join and leave are only sent by the
+// server.
+func isClientEvent(msg *pb.ChatMessage) bool {
+	switch event := msg.GetEvent().(type) {
+	case *pb.ChatMessage_MessageContent:
+		return event.MessageContent != ""
+	case *pb.ChatMessage_Typing:
+		return true
+	case *pb.ChatMessage_ReadReceipt:
+		return event.ReadReceipt.GetMessageId() != ""
+	}
+	return false
+}
+
+// ListRoomParticipants lists who is connected to the chat rooms.
+func (pserv *ProductServer) ListRoomParticipants(ctx context.Context, req *pb.ListRoomParticipantsRequest) (*pb.ListRoomParticipantsResponse, error) {
+	return &pb.ListRoomParticipantsResponse{Rooms: pserv.chat.participants(req.GetRoom())}, nil
+}
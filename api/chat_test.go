@@ -0,0 +1,83 @@
+package api
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	pb "github.com/bharat-rajani/grpc-products-demo/gen/proto/products"
+	"google.golang.org/grpc"
+)
+
+type fakeChatStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	received []*pb.ChatMessage
+}
+
+func (f *fakeChatStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeChatStream) Recv() (*pb.ChatMessage, error) {
+	if len(f.received) == 0 {
+		return nil, io.EOF
+	}
+	msg := f.received[0]
+	f.received = f.received[1:]
+	return msg, nil
+}
+
+func (f *fakeChatStream) Send(*pb.ChatMessage) error {
+	return nil
+}
+
+func TestChatFloodKeepsMembersConnected(t *testing.T) {
+	tests := []struct {
+		name  string
+		event func() *pb.ChatMessage
+	}{
+		{
+			name: "typing",
+			event: func() *pb.ChatMessage {
+				return &pb.ChatMessage{Event: &pb.ChatMessage_Typing{Typing: &pb.ChatTyping{}}}
+			},
+		},
+		{
+			name: "read receipt",
+			event: func() *pb.ChatMessage {
+				return &pb.ChatMessage{Event: &pb.ChatMessage_ReadReceipt{ReadReceipt: &pb.ChatReadReceipt{MessageId: "m1"}}}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pserv := NewProductServer(NewMemoryStore(Catalog{
+				Vendors: []Vendor{{Name: "aws", ProductTypes: []string{"storage"}, Enabled: true}},
+			}), NewShortener("http://localhost:8081"), WithChatModerator(NewChatModerator(ChatRules{MessageRate: 1, MessageBurst: 1})))
+
+			// the listener never drains its buffer
+			listener, _ := pserv.chat.join("aws", "listener", &pb.ChatMessage{})
+			defer listener.leave()
+
+			stream := &fakeChatStream{ctx: context.Background()}
+			for i := 0; i < 1000; i++ {
+				msg := tt.event()
+				msg.Room, msg.Sender = "aws", "flooder"
+				stream.received = append(stream.received, msg)
+			}
+			if err := pserv.ChatVendorSales(stream); err != nil {
+				t.Fatalf("ChatVendorSales() = %v", err)
+			}
+
+			if err := listener.Err(); err != nil {
+				t.Fatalf("listener was dropped: %v", err)
+			}
+			// both joins and the leave of the flooder, besides its events
+			if n := len(listener.C); n > 4 {
+				t.Errorf("listener received %d events, want at most 4", n)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	pb "github.com/bharat-rajani/grpc-products-demo/gen/proto/products"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ChatBotName is the sender of the answers to the chat bot commands. Chat
+// members cannot use it.
+const ChatBotName = "salesbot"
+
+// chatBotMaxProducts is the number of products listed in one answer.
+const chatBotMaxProducts = 20
+
+// ChatCommandFunc answers a bot command posted in the chat room of a vendor.
+// args are the words following the command name. The message of a returned
+// error is posted in place of the answer.
+type ChatCommandFunc func(ctx context.Context, room string, args []string) (string, error)
+
+// ChatCommand is a bot command, posted in a chat room as "/<Name> args...".
+type ChatCommand struct {
+	Name  string
+	Usage string
+	Help  string
+	Run   ChatCommandFunc
+}
+
+// WithChatCommand adds a bot command to the chat rooms, replacing the
+// built-in command of the same name.
+func WithChatCommand(command ChatCommand) ServerOption {
+	return func(pserv *ProductServer) {
+		pserv.commands = append(pserv.commands, command)
+	}
+}
+
+// chatBot answers the text messages starting with a slash from its registry
+// of commands.
+type chatBot struct {
+	commands map[string]ChatCommand
+}
+
+func newChatBot() *chatBot {
+	bot := &chatBot{commands: make(map[string]ChatCommand)}
+	bot.register(ChatCommand{
+		Name: "help",
+		Help: "lists the bot commands",
+		Run:  bot.help,
+	})
+	return bot
+}
+
+func (bot *chatBot) register(command ChatCommand) {
+	bot.commands[command.Name] = command
+}
+
+// answer returns the reply of the bot to msg, if msg is a command.
+func (bot *chatBot) answer(ctx context.Context, msg *pb.ChatMessage) (*pb.ChatMessage, bool) {
+	words := strings.Fields(msg.GetMessageContent())
+	if len(words) == 0 || !strings.HasPrefix(words[0], "/") {
+		return nil, false
+	}
+	name := strings.ToLower(strings.TrimPrefix(words[0], "/"))
+
+	var text string
+	command, found := bot.commands[name]
+	if !found {
+		text = fmt.Sprintf("unknown command /%s, try /help", name)
+	} else if answer, err := command.Run(ctx, msg.GetRoom(), words[1:]); err != nil {
+		text = fmt.Sprintf("/%s: %s", name, status.Convert(err).Message())
+	} else {
+		text = answer
+	}
+
+	reply := newChatEvent(msg.GetRoom(), ChatBotName)
+	reply.Event = &pb.ChatMessage_MessageContent{MessageContent: text}
+	return reply, true
+}
+
+func (bot *chatBot) help(ctx context.Context, room string, args []string) (string, error) {
+	names := make([]string, 0, len(bot.commands))
+	for name := range bot.commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	lines := []string{"commands:"}
+	for _, name := range names {
+		command := bot.commands[name]
+		usage := "/" + name
+		if command.Usage != "" {
+			usage += " " + command.Usage
+		}
+		lines = append(lines, fmt.Sprintf("  %s - %s", usage, command.Help))
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// chatCommands returns the built-in commands answering from the catalog.
+func (pserv *ProductServer) chatCommands() []ChatCommand {
+	return []ChatCommand{
+		{
+			Name:  "types",
+			Usage: "[vendor]",
+			Help:  "lists the product types of a vendor, the one of the room by default",
+			Run:   pserv.typesCommand,
+		},
+		{
+			Name:  "products",
+			Usage: "[vendor] <product type>",
+			Help:  "lists the products of a vendor under a product type",
+			Run:   pserv.productsCommand,
+		},
+	}
+}
+
+// typesCommand answers like GetVendorProductTypes.
+func (pserv *ProductServer) typesCommand(ctx context.Context, room string, args []string) (string, error) {
+	vendorName := room
+	switch len(args) {
+	case 0:
+	case 1:
+		vendorName = args[0]
+	default:
+		return "", status.Error(codes.InvalidArgument, "usage /types [vendor]")
+	}
+	if err := pserv.checkVendor(vendorName); err != nil {
+		return "", err
+	}
+	vendor, err := pserv.store.GetVendor(vendorName)
+	if err != nil {
+		return "", storeError(err)
+	}
+	if len(vendor.ProductTypes) == 0 {
+		return fmt.Sprintf("%s offers no product types", vendor.DisplayName), nil
+	}
+
+	lines := []string{fmt.Sprintf("%s product types:", vendor.DisplayName)}
+	for _, prodType := range vendor.ProductTypes {
+		products, err := pserv.store.ListProducts(vendorName, prodType)
+		if err != nil {
+			return "", storeError(err)
+		}
+		lines = append(lines, fmt.Sprintf("  %s (%d products)", prodType, len(products)))
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// productsCommand answers like a snapshot of GetVendorProducts.
+func (pserv *ProductServer) productsCommand(ctx context.Context, room string, args []string) (string, error) {
+	vendorName, prodType := room, ""
+	switch len(args) {
+	case 1:
+		prodType = args[0]
+	case 2:
+		vendorName, prodType = args[0], args[1]
+	default:
+		return "", status.Error(codes.InvalidArgument, "usage /products [vendor] <product type>")
+	}
+	if err := pserv.checkVendor(vendorName); err != nil {
+		return "", err
+	}
+	products, err := pserv.store.ListProducts(vendorName, prodType)
+	if err == ErrUnknownProductType {
+		return "", status.Errorf(codes.NotFound, "%s does not offer %s products, try /types %s", vendorName, prodType, vendorName)
+	}
+	if err != nil {
+		return "", storeError(err)
+	}
+	if len(products) == 0 {
+		return fmt.Sprintf("no %s products from %s", prodType, vendorName), nil
+	}
+
+	lines := []string{fmt.Sprintf("%s %s products:", vendorName, prodType)}
+	for i, product := range products {
+		if i == chatBotMaxProducts {
+			lines = append(lines, fmt.Sprintf("  ... and %d more", len(products)-i))
+			break
+		}
+		link := pserv.links.ShortURL(product.URL)
+		if link == "" {
+			link = product.URL
+		}
+		lines = append(lines, fmt.Sprintf("  %s %s", product.Title, link))
+	}
+	return strings.Join(lines, "\n"), nil
+}
@@ -0,0 +1,196 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	pb "github.com/bharat-rajani/grpc-products-demo/gen/proto/products"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	chatHistoryFile = "chat.wal"
+
+	// chatHistorySize is the number of messages kept per room.
+	chatHistorySize = 500
+	// chatCompactEvery is the number of messages logged since the last
+	// compaction after which the history file is rewritten with only the kept
+	// messages.
+	chatCompactEvery = 10000
+)
+
+// chatRecord is one chat message in the history log.
+type chatRecord struct {
+	ID      string    `json:"id"`
+	Room    string    `json:"room"`
+	Sender  string    `json:"sender"`
+	Content string    `json:"content"`
+	Time    time.Time `json:"time"`
+}
+
+func newChatRecord(msg *pb.ChatMessage) chatRecord {
+	return chatRecord{
+		ID:      msg.GetId(),
+		Room:    msg.GetRoom(),
+		Sender:  msg.GetSender(),
+		Content: msg.GetMessageContent(),
+		Time:    msg.GetTimestamp().AsTime(),
+	}
+}
+
+func (r chatRecord) toProto() *pb.ChatMessage {
+	return &pb.ChatMessage{
+		Id:        r.ID,
+		Room:      r.Room,
+		Sender:    r.Sender,
+		Event:     &pb.ChatMessage_MessageContent{MessageContent: r.Content},
+		Timestamp: timestamppb.New(r.Time),
+	}
+}
+
+// ChatHistory keeps the last messages of every chat room, optionally
+// persisted in an append-only log that is compacted once it grows well past
+// what is kept.
+type ChatHistory struct {
+	mu    sync.Mutex
+	size  int
+	rooms map[string][]*pb.ChatMessage
+	// log persists the messages, nil when kept in memory only
+	log  *wal
+	path string
+	// logged counts the messages appended since the last compaction
+	logged int
+}
+
+// NewChatHistory returns a ChatHistory keeping the messages in memory.
+func NewChatHistory() *ChatHistory {
+	return &ChatHistory{size: chatHistorySize, rooms: make(map[string][]*pb.ChatMessage)}
+}
+
+// OpenChatHistory returns a ChatHistory persisting the messages in dir,
+// creating dir if needed.
+func OpenChatHistory(dir string) (*ChatHistory, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	h := NewChatHistory()
+	h.path = filepath.Join(dir, chatHistoryFile)
+	var err error
+	h.log, err = openWAL(h.path)
+	if err != nil {
+		return nil, err
+	}
+	err = h.log.replay(func(line json.RawMessage) error {
+		var record chatRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return fmt.Errorf("decoding %s: %v", h.path, err)
+		}
+		h.keep(record.toProto())
+		h.logged++
+		return nil
+	})
+	if err != nil {
+		h.log.Close()
+		return nil, err
+	}
+	log.Printf("loaded chat history of %d rooms from %s", len(h.rooms), h.path)
+	return h, nil
+}
+
+// add keeps msg, dropping the oldest message of its room if it is full.
+func (h *ChatHistory) add(msg *pb.ChatMessage) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.keep(msg)
+	if h.log == nil {
+		return nil
+	}
+	if err := h.log.append(newChatRecord(msg)); err != nil {
+		return err
+	}
+	h.logged++
+	if h.logged >= chatCompactEvery {
+		return h.compact()
+	}
+	return nil
+}
+
+// keep must be called with h.mu held.
+func (h *ChatHistory) keep(msg *pb.ChatMessage) {
+	msgs := append(h.rooms[msg.GetRoom()], msg)
+	if len(msgs) > h.size {
+		msgs = msgs[len(msgs)-h.size:]
+	}
+	h.rooms[msg.GetRoom()] = msgs
+}
+
+// compact rewrites the log with the kept messages. It must be called with
+// h.mu held. On failure the current log stays in use and compaction is
+// retried with the next message; as the compacted file is written from the
+// kept messages, a retry also saves the ones appended in between.
+func (h *ChatHistory) compact() error {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, msgs := range h.rooms {
+		for _, msg := range msgs {
+			if err := encoder.Encode(newChatRecord(msg)); err != nil {
+				return err
+			}
+		}
+	}
+	if err := writeFileAtomic(h.path, buf.Bytes()); err != nil {
+		return err
+	}
+	compacted, err := openWAL(h.path)
+	if err != nil {
+		return err
+	}
+	if err := h.log.Close(); err != nil {
+		log.Printf("could not close the compacted chat history log: %v", err)
+	}
+	h.log = compacted
+	h.logged = 0
+	return nil
+}
+
+// since returns the kept messages of room posted after the message sinceID,
+// or all of them if sinceID is not kept anymore. With no sinceID it returns
+// the messages posted at or after since, and nothing if since is nil.
+func (h *ChatHistory) since(room, sinceID string, since *timestamppb.Timestamp) []*pb.ChatMessage {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	msgs := h.rooms[room]
+	switch {
+	case sinceID != "":
+		for i, msg := range msgs {
+			if msg.GetId() == sinceID {
+				return append([]*pb.ChatMessage(nil), msgs[i+1:]...)
+			}
+		}
+		return append([]*pb.ChatMessage(nil), msgs...)
+	case since != nil:
+		from := since.AsTime()
+		for i, msg := range msgs {
+			if !msg.GetTimestamp().AsTime().Before(from) {
+				return append([]*pb.ChatMessage(nil), msgs[i:]...)
+			}
+		}
+	}
+	return nil
+}
+
+// Close releases the file persisting the messages.
+func (h *ChatHistory) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.log == nil {
+		return nil
+	}
+	return h.log.Close()
+}
@@ -0,0 +1,367 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/bharat-rajani/grpc-products-demo/gen/proto/products"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	hourSeconds = int64(time.Hour / time.Second)
+	daySeconds  = 24 * hourSeconds
+
+	// clickFlushInterval is how often the clicks recorded since the last
+	// flush are appended to the clicks log, with a single sync.
+	clickFlushInterval = time.Second
+	// clickCompactEvery is the number of clicks logged since the last
+	// compaction after which the log is rewritten with the hourly counts.
+	clickCompactEvery = 10000
+)
+
+// click is one followed short URL, as recorded in the clicks log.
+type click struct {
+	Time      time.Time `json:"time"`
+	Code      string    `json:"code"`
+	UserAgent string    `json:"userAgent,omitempty"`
+	Referrer  string    `json:"referrer,omitempty"`
+	// Count is set on the hourly counts of a compacted log, a click counts
+	// once otherwise
+	Count int64 `json:"count,omitempty"`
+}
+
+// clickCounter counts the short URL clicks per code and UTC hour. When
+// persisted, the clicks are appended to a log every clickFlushInterval and
+// counted again on open, so a crash loses the clicks of the last interval at
+// most. The log is compacted into the hourly counts once it grows well past
+// them.
+type clickCounter struct {
+	mu sync.RWMutex
+	// hourly maps codes to the unix start of their clicked hours to clicks
+	hourly map[string]map[int64]int64
+	// pending are the clicks counted but not logged yet
+	pending []click
+
+	// flushMu serialises the flushes, which own log and logged
+	flushMu sync.Mutex
+	log     *wal
+	path    string
+	// logged counts the records appended since the last compaction
+	logged int
+	// done stops the flushing goroutine, which closes stopped
+	done    chan struct{}
+	stopped chan struct{}
+}
+
+func newClickCounter() *clickCounter {
+	return &clickCounter{hourly: make(map[string]map[int64]int64)}
+}
+
+func openClickCounter(path string) (*clickCounter, error) {
+	c := newClickCounter()
+	c.path = path
+	var err error
+	c.log, err = openWAL(path)
+	if err != nil {
+		return nil, err
+	}
+	err = c.log.replay(func(line json.RawMessage) error {
+		var hit click
+		if err := json.Unmarshal(line, &hit); err != nil {
+			return fmt.Errorf("decoding %s: %v", path, err)
+		}
+		c.count(hit)
+		c.logged++
+		return nil
+	})
+	if err != nil {
+		c.log.Close()
+		return nil, err
+	}
+	c.done = make(chan struct{})
+	c.stopped = make(chan struct{})
+	go c.flushEvery(clickFlushInterval)
+	return c, nil
+}
+
+// record counts hit, which is logged with the next flush.
+func (c *clickCounter) record(hit click) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.count(hit)
+	if c.log != nil {
+		c.pending = append(c.pending, hit)
+	}
+}
+
+// count must be called with c.mu held, or before c is shared.
+func (c *clickCounter) count(hit click) {
+	hours, found := c.hourly[hit.Code]
+	if !found {
+		hours = make(map[int64]int64)
+		c.hourly[hit.Code] = hours
+	}
+	clicks := hit.Count
+	if clicks == 0 {
+		clicks = 1
+	}
+	unix := hit.Time.Unix()
+	hours[unix-unix%hourSeconds] += clicks
+}
+
+func (c *clickCounter) flushEvery(interval time.Duration) {
+	defer close(c.stopped)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+			if err := c.flush(); err != nil {
+				log.Printf("could not log clicks: %v", err)
+			}
+		}
+	}
+}
+
+// flush appends the pending clicks to the log, or compacts the log if it
+// grew past clickCompactEvery records. Clicks that could not be logged stay
+// pending.
+func (c *clickCounter) flush() error {
+	c.flushMu.Lock()
+	defer c.flushMu.Unlock()
+	c.mu.Lock()
+	pending := c.pending
+	c.pending = nil
+	var counts []click
+	if len(pending) > 0 && c.logged+len(pending) >= clickCompactEvery {
+		counts = c.hourlyCounts()
+	}
+	c.mu.Unlock()
+	if len(pending) == 0 {
+		return nil
+	}
+
+	// the hourly counts hold the pending clicks already
+	if counts != nil {
+		err := c.compact(counts)
+		if err == nil {
+			return nil
+		}
+		log.Printf("could not compact the clicks log: %v", err)
+	}
+	records := make([]interface{}, len(pending))
+	for i, hit := range pending {
+		records[i] = hit
+	}
+	if err := c.log.appendAll(records); err != nil {
+		c.mu.Lock()
+		c.pending = append(pending, c.pending...)
+		c.mu.Unlock()
+		return err
+	}
+	c.logged += len(pending)
+	return nil
+}
+
+// hourlyCounts returns one record per code and clicked hour. It must be
+// called with c.mu held.
+func (c *clickCounter) hourlyCounts() []click {
+	var counts []click
+	for code, hours := range c.hourly {
+		for hour, clicks := range hours {
+			counts = append(counts, click{Time: time.Unix(hour, 0).UTC(), Code: code, Count: clicks})
+		}
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if !counts[i].Time.Equal(counts[j].Time) {
+			return counts[i].Time.Before(counts[j].Time)
+		}
+		return counts[i].Code < counts[j].Code
+	})
+	return counts
+}
+
+// compact rewrites the log with counts. It must be called with c.flushMu
+// held. On failure the current log stays in use.
+func (c *clickCounter) compact(counts []click) error {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, hit := range counts {
+		if err := encoder.Encode(hit); err != nil {
+			return err
+		}
+	}
+	if err := writeFileAtomic(c.path, buf.Bytes()); err != nil {
+		return err
+	}
+	compacted, err := openWAL(c.path)
+	if err != nil {
+		return err
+	}
+	if err := c.log.Close(); err != nil {
+		log.Printf("could not close the compacted clicks log: %v", err)
+	}
+	c.log = compacted
+	c.logged = 0
+	return nil
+}
+
+// buckets adds the clicks on code from the hours starting in [since, until)
+// to the buckets of width seconds they fall in. A zero until is unbounded.
+func (c *clickCounter) buckets(code string, since, until time.Time, width int64, buckets map[int64]int64) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for hour, clicks := range c.hourly[code] {
+		if hour < since.Unix()-since.Unix()%hourSeconds || (!until.IsZero() && hour >= until.Unix()) {
+			continue
+		}
+		buckets[hour-hour%width] += clicks
+	}
+}
+
+// Close logs the pending clicks and closes the log, if any.
+func (c *clickCounter) Close() error {
+	if c.log == nil {
+		return nil
+	}
+	close(c.done)
+	<-c.stopped
+	err := c.flush()
+	if closeErr := c.log.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// GetShortUrlStats counts the clicks on the short URLs of the catalog per
+// product and vendor. Products sharing a URL share its short URL, and so its
+// clicks.
+func (pserv *ProductServer) GetShortUrlStats(ctx context.Context, req *pb.GetShortUrlStatsRequest) (*pb.GetShortUrlStatsResponse, error) {
+	log.Printf("have received a request for the short URL stats of -> %s <- vendor", req.GetVendor())
+
+	var width int64
+	switch req.GetBucket() {
+	case pb.StatsBucket_STATS_BUCKET_HOUR:
+		width = hourSeconds
+	case pb.StatsBucket_STATS_BUCKET_DAY:
+		width = daySeconds
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown stats bucket %v", req.GetBucket())
+	}
+	var since, until time.Time
+	if req.GetSince() != nil {
+		if !req.GetSince().IsValid() {
+			return nil, status.Error(codes.InvalidArgument, "invalid since timestamp")
+		}
+		since = req.GetSince().AsTime()
+	}
+	if req.GetUntil() != nil {
+		if !req.GetUntil().IsValid() {
+			return nil, status.Error(codes.InvalidArgument, "invalid until timestamp")
+		}
+		until = req.GetUntil().AsTime()
+		if !until.After(since) {
+			return nil, status.Error(codes.InvalidArgument, "until must be after since")
+		}
+	}
+
+	var products []Product
+	if req.GetProductId() != "" {
+		product, err := pserv.store.GetProduct(req.GetProductId())
+		if err != nil {
+			return nil, storeError(err)
+		}
+		if req.GetVendor() != "" && product.Vendor != req.GetVendor() {
+			return nil, status.Errorf(codes.NotFound, "product %s is not sold by %s", product.ID, req.GetVendor())
+		}
+		products = append(products, product)
+	} else {
+		var err error
+		products, err = pserv.listAllProducts(req.GetVendor(), "")
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not list products: %v", err)
+		}
+	}
+
+	response := &pb.GetShortUrlStatsResponse{}
+	vendorBuckets := make(map[string]map[int64]int64)
+	// a URL listed twice by a vendor is counted once for it
+	vendorURLs := make(map[string]map[string]bool)
+	for _, product := range products {
+		code, found := pserv.links.code(product.URL)
+		if !found {
+			continue
+		}
+		buckets := make(map[int64]int64)
+		pserv.links.clicks.buckets(code, since, until, width, buckets)
+		if len(buckets) == 0 {
+			continue
+		}
+		stats := &pb.ProductClickStats{Product: product.catalogProto(pserv.links)}
+		stats.TotalClicks, stats.Buckets = bucketProtos(buckets)
+		response.Products = append(response.Products, stats)
+
+		if vendorURLs[product.Vendor] == nil {
+			vendorURLs[product.Vendor] = make(map[string]bool)
+			vendorBuckets[product.Vendor] = make(map[int64]int64)
+		}
+		if !vendorURLs[product.Vendor][product.URL] {
+			vendorURLs[product.Vendor][product.URL] = true
+			for start, clicks := range buckets {
+				vendorBuckets[product.Vendor][start] += clicks
+			}
+		}
+	}
+	for vendor, buckets := range vendorBuckets {
+		stats := &pb.VendorClickStats{Vendor: vendor}
+		stats.TotalClicks, stats.Buckets = bucketProtos(buckets)
+		response.Vendors = append(response.Vendors, stats)
+	}
+
+	sort.Slice(response.Products, func(i, j int) bool {
+		a, b := response.Products[i], response.Products[j]
+		if a.TotalClicks != b.TotalClicks {
+			return a.TotalClicks > b.TotalClicks
+		}
+		return a.GetProduct().GetProduct().GetId() < b.GetProduct().GetProduct().GetId()
+	})
+	sort.Slice(response.Vendors, func(i, j int) bool {
+		a, b := response.Vendors[i], response.Vendors[j]
+		if a.TotalClicks != b.TotalClicks {
+			return a.TotalClicks > b.TotalClicks
+		}
+		return a.Vendor < b.Vendor
+	})
+	return response, nil
+}
+
+// bucketProtos returns the total of buckets and buckets in chronological order.
+func bucketProtos(buckets map[int64]int64) (int64, []*pb.ClickBucket) {
+	starts := make([]int64, 0, len(buckets))
+	for start := range buckets {
+		starts = append(starts, start)
+	}
+	sort.Slice(starts, func(i, j int) bool { return starts[i] < starts[j] })
+
+	var total int64
+	protos := make([]*pb.ClickBucket, 0, len(starts))
+	for _, start := range starts {
+		total += buckets[start]
+		protos = append(protos, &pb.ClickBucket{
+			Start:  timestamppb.New(time.Unix(start, 0)),
+			Clicks: buckets[start],
+		})
+	}
+	return total, protos
+}
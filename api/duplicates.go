@@ -0,0 +1,169 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	pb "github.com/bharat-rajani/grpc-products-demo/gen/proto/products"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrDuplicateProduct is returned when adding a product whose title is
+// already listed by its vendor under its product type.
+var ErrDuplicateProduct = errors.New("product with the same title already listed")
+
+// DuplicatePolicy decides what happens to a new product whose normalized
+// title is already listed by its vendor under its product type.
+type DuplicatePolicy int
+
+const (
+	// DuplicateReject refuses the new product.
+	DuplicateReject DuplicatePolicy = iota
+	// DuplicateUpsert overwrites the listed product with the new one.
+	DuplicateUpsert
+	// DuplicateKeep lists both products.
+	DuplicateKeep
+)
+
+var duplicatePolicyNames = []string{"reject", "upsert", "keep"}
+
+func (p DuplicatePolicy) String() string {
+	if p < 0 || int(p) >= len(duplicatePolicyNames) {
+		return fmt.Sprintf("DuplicatePolicy(%d)", int(p))
+	}
+	return duplicatePolicyNames[p]
+}
+
+// ParseDuplicatePolicy returns the policy named "reject", "upsert" or "keep".
+func ParseDuplicatePolicy(name string) (DuplicatePolicy, error) {
+	for i, policyName := range duplicatePolicyNames {
+		if name == policyName {
+			return DuplicatePolicy(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown duplicate policy %q, select between %s", name, strings.Join(duplicatePolicyNames, ", "))
+}
+
+// normalizeTitle folds the case and spacing of title, so that "App Engine"
+// and " app  engine" compare equal.
+func normalizeTitle(title string) string {
+	return strings.ToLower(strings.Join(strings.Fields(title), " "))
+}
+
+// findDuplicate returns another product listed with the normalized title of
+// product by its vendor under its product type.
+func (pserv *ProductServer) findDuplicate(product Product) (Product, bool, error) {
+	products, err := pserv.store.ListProducts(product.Vendor, product.ProductType)
+	switch err {
+	case nil:
+	case ErrUnknownVendor, ErrUnknownProductType:
+		return Product{}, false, nil
+	default:
+		return Product{}, false, err
+	}
+	title := normalizeTitle(product.Title)
+	for _, listed := range products {
+		if listed.ID != product.ID && normalizeTitle(listed.Title) == title {
+			return listed, true, nil
+		}
+	}
+	return Product{}, false, nil
+}
+
+// MergeDuplicateProducts reports the groups of products sharing a vendor,
+// product type and normalized title, and unless dryRun is set merges every
+// group into its oldest product, deleting the others.
+func (pserv *ProductServer) MergeDuplicateProducts(ctx context.Context, req *pb.MergeDuplicateProductsRequest) (*pb.MergeDuplicateProductsResponse, error) {
+	log.Printf("have received a request to merge duplicate products of -> %s <- vendor, dry run: %v", req.GetVendor(), req.GetDryRun())
+
+	pserv.catalogMu.Lock()
+	defer pserv.catalogMu.Unlock()
+
+	vendors, err := pserv.store.ListVendors()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not list vendors: %v", err)
+	}
+	response := &pb.MergeDuplicateProductsResponse{}
+	for _, vendor := range vendors {
+		if req.GetVendor() != "" && vendor.Name != req.GetVendor() {
+			continue
+		}
+		for _, prodType := range vendor.ProductTypes {
+			if req.GetProductType() != "" && prodType != req.GetProductType() {
+				continue
+			}
+			products, err := pserv.store.ListProducts(vendor.Name, prodType)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "could not list products: %v", err)
+			}
+			for _, group := range duplicateGroups(products) {
+				kept, err := pserv.mergeDuplicates(group, req.GetDryRun())
+				if err != nil {
+					return nil, storeError(err)
+				}
+				merged := &pb.DuplicateGroup{Kept: kept.catalogProto(pserv.links)}
+				for _, duplicate := range group[1:] {
+					merged.Duplicates = append(merged.Duplicates, duplicate.catalogProto(pserv.links))
+				}
+				response.Groups = append(response.Groups, merged)
+				response.RemovedCount += int32(len(group) - 1)
+			}
+		}
+	}
+	return response, nil
+}
+
+// duplicateGroups returns the products sharing a normalized title, each
+// group in listing order.
+func duplicateGroups(products []Product) [][]Product {
+	var titles []string
+	byTitle := make(map[string][]Product)
+	for _, product := range products {
+		title := normalizeTitle(product.Title)
+		if _, found := byTitle[title]; !found {
+			titles = append(titles, title)
+		}
+		byTitle[title] = append(byTitle[title], product)
+	}
+	var groups [][]Product
+	for _, title := range titles {
+		if len(byTitle[title]) > 1 {
+			groups = append(groups, byTitle[title])
+		}
+	}
+	return groups
+}
+
+// mergeDuplicates keeps the first product of group, taking its URL from the
+// others if it has none, and deletes the others. It must be called with
+// pserv.catalogMu held.
+func (pserv *ProductServer) mergeDuplicates(group []Product, dryRun bool) (Product, error) {
+	kept := group[0]
+	for _, duplicate := range group[1:] {
+		if kept.URL == "" {
+			kept.URL = duplicate.URL
+		}
+	}
+	if dryRun {
+		return kept, nil
+	}
+
+	if kept != group[0] {
+		if err := pserv.store.UpdateProduct(kept); err != nil {
+			return Product{}, err
+		}
+		pserv.shortenProduct(kept, group[0].URL)
+		pserv.index.add(kept)
+	}
+	for _, duplicate := range group[1:] {
+		if err := pserv.store.DeleteProduct(duplicate.ID); err != nil && err != ErrProductNotFound {
+			return Product{}, err
+		}
+		pserv.index.remove(duplicate.ID)
+	}
+	return kept, nil
+}
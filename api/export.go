@@ -0,0 +1,125 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"log"
+
+	pb "github.com/bharat-rajani/grpc-products-demo/gen/proto/products"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// exportFormatVersion is the version of the ExportCatalog layout.
+const exportFormatVersion = 1
+
+// catalogHasher computes the checksum of an export. The vendor an export is
+// restricted to and every vendor and product are hashed as one line of
+// quoted fields, so that no two catalogs share the same input. Short URLs are
+// left out as they depend on the instance.
+type catalogHasher struct {
+	h hash.Hash
+}
+
+// newCatalogHasher returns the hasher of an export restricted to vendor, or
+// of the whole catalog if vendor is empty.
+func newCatalogHasher(vendor string) *catalogHasher {
+	c := &catalogHasher{h: sha256.New()}
+	if vendor != "" {
+		fmt.Fprintf(c.h, "scope %q\n", vendor)
+	}
+	return c
+}
+
+func (c *catalogHasher) addVendor(vendor *pb.Vendor) {
+	fmt.Fprintf(c.h, "vendor %q %q %q %t %q\n", vendor.GetName(), vendor.GetDisplayName(), vendor.GetHomepage(), vendor.GetEnabled(), vendor.GetProductTypes())
+}
+
+func (c *catalogHasher) addProduct(product *pb.CatalogProduct) {
+	fmt.Fprintf(c.h, "product %q %q %q %q %q\n", product.GetProduct().GetId(), product.GetVendor(), product.GetProductType(), product.GetProduct().GetTitle(), product.GetProduct().GetUrl())
+}
+
+func (c *catalogHasher) sum() string {
+	return hex.EncodeToString(c.h.Sum(nil))
+}
+
+// ExportCatalog streams a copy of the catalog taken while no product is
+// being added.
+func (pserv *ProductServer) ExportCatalog(req *pb.ExportCatalogRequest, stream pb.ProductService_ExportCatalogServer) error {
+	log.Printf("have received a request to export the catalog of -> %s <- vendor", req.GetVendor())
+
+	vendors, products, err := pserv.exportCatalog(req.GetVendor())
+	if err != nil {
+		return err
+	}
+
+	header := &pb.ExportHeader{
+		FormatVersion: exportFormatVersion,
+		ExportedAt:    timestamppb.Now(),
+		VendorCount:   int32(len(vendors)),
+		ProductCount:  int32(len(products)),
+		Vendor:        req.GetVendor(),
+	}
+	if err := stream.Send(&pb.ExportRecord{Record: &pb.ExportRecord_Header{Header: header}}); err != nil {
+		return err
+	}
+	hasher := newCatalogHasher(req.GetVendor())
+	for _, vendor := range vendors {
+		hasher.addVendor(vendor)
+		if err := stream.Send(&pb.ExportRecord{Record: &pb.ExportRecord_Vendor{Vendor: vendor}}); err != nil {
+			return err
+		}
+	}
+	for _, product := range products {
+		if err := stream.Context().Err(); err != nil {
+			return contextError(err)
+		}
+		hasher.addProduct(product)
+		if err := stream.Send(&pb.ExportRecord{Record: &pb.ExportRecord_Product{Product: product}}); err != nil {
+			return err
+		}
+	}
+	trailer := &pb.ExportTrailer{
+		VendorCount:  header.VendorCount,
+		ProductCount: header.ProductCount,
+		Checksum:     hasher.sum(),
+	}
+	log.Printf("have exported %d vendors and %d products", len(vendors), len(products))
+	return stream.Send(&pb.ExportRecord{Record: &pb.ExportRecord_Trailer{Trailer: trailer}})
+}
+
+// exportCatalog copies the vendors, restricted to vendor if set, and their
+// products.
+func (pserv *ProductServer) exportCatalog(vendor string) ([]*pb.Vendor, []*pb.CatalogProduct, error) {
+	pserv.catalogMu.Lock()
+	defer pserv.catalogMu.Unlock()
+
+	allVendors, err := pserv.store.ListVendors()
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "could not list vendors: %v", err)
+	}
+	var vendors []*pb.Vendor
+	var products []*pb.CatalogProduct
+	for _, v := range allVendors {
+		if vendor != "" && v.Name != vendor {
+			continue
+		}
+		vendors = append(vendors, v.toProto())
+		for _, prodType := range v.ProductTypes {
+			typeProducts, err := pserv.store.ListProducts(v.Name, prodType)
+			if err != nil {
+				return nil, nil, status.Errorf(codes.Internal, "could not list products: %v", err)
+			}
+			for _, product := range typeProducts {
+				products = append(products, product.catalogProto(pserv.links))
+			}
+		}
+	}
+	if vendor != "" && len(vendors) == 0 {
+		return nil, nil, storeError(ErrUnknownVendor)
+	}
+	return vendors, products, nil
+}
@@ -0,0 +1,349 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	snapshotFile = "catalog.snapshot"
+	walFile      = "catalog.wal"
+
+	// snapshotEvery is the number of logged mutations after which the
+	// catalog is snapshotted and the write-ahead log truncated.
+	snapshotEvery = 1000
+)
+
+const (
+	opPutProduct    = "put_product"
+	opUpdateProduct = "update_product"
+	opDeleteProduct = "delete_product"
+	opCreateVendor  = "create_vendor"
+	opUpdateVendor  = "update_vendor"
+	opDeleteVendor  = "delete_vendor"
+	opReplace       = "replace_catalog"
+)
+
+// walRecord is one catalog mutation in the write-ahead log.
+type walRecord struct {
+	Seq     uint64   `json:"seq"`
+	Op      string   `json:"op"`
+	Product *Product `json:"product,omitempty"`
+	Vendor  *Vendor  `json:"vendor,omitempty"`
+	Catalog *Catalog `json:"catalog,omitempty"`
+	// IdempotencyKey and Time are set for products put or updated with a key
+	IdempotencyKey string     `json:"idempotencyKey,omitempty"`
+	Time           *time.Time `json:"time,omitempty"`
+}
+
+type snapshot struct {
+	Seq uint64 `json:"seq"`
+	Catalog
+	Puts []idempotentPut `json:"idempotentPuts,omitempty"`
+}
+
+// FileStore is a ProductStore persisted in a data directory. Every mutation
+// is appended to a write-ahead log before it is applied to the in-memory
+// catalog, and the catalog is periodically snapshotted so the log stays
+// short. Opening a FileStore replays the snapshot and the log.
+type FileStore struct {
+	// mu serialises mutations so the log order matches the apply order.
+	mu      sync.Mutex
+	mem     *MemoryStore
+	dir     string
+	log     *wal
+	seq     uint64
+	pending int
+}
+
+// OpenFileStore opens the catalog kept in dir, creating dir if needed. A
+// fresh directory is seeded with the given catalog.
+func OpenFileStore(dir string, seed Catalog) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	store := &FileStore{mem: NewMemoryStore(Catalog{}), dir: dir}
+
+	fresh := true
+	data, err := ioutil.ReadFile(filepath.Join(dir, snapshotFile))
+	switch {
+	case err == nil:
+		var snap snapshot
+		if err := json.Unmarshal(data, &snap); err != nil {
+			return nil, fmt.Errorf("decoding %s: %v", snapshotFile, err)
+		}
+		store.mem = NewMemoryStore(snap.Catalog)
+		store.mem.restorePuts(snap.Puts)
+		store.seq = snap.Seq
+		fresh = false
+	case !os.IsNotExist(err):
+		return nil, err
+	}
+
+	store.log, err = openWAL(filepath.Join(dir, walFile))
+	if err != nil {
+		return nil, err
+	}
+	replayed := 0
+	err = store.log.replay(func(line json.RawMessage) error {
+		var record walRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return fmt.Errorf("decoding %s: %v", walFile, err)
+		}
+		// records already covered by the snapshot are left over from a
+		// crash between writing the snapshot and truncating the log
+		if record.Seq <= store.seq {
+			return nil
+		}
+		if err := store.apply(record); err != nil {
+			return err
+		}
+		store.seq = record.Seq
+		replayed++
+		return nil
+	})
+	if err != nil {
+		store.log.Close()
+		return nil, err
+	}
+	store.pending = replayed
+	log.Printf("catalog loaded from %s, replayed %d log records", dir, replayed)
+
+	if fresh && replayed == 0 {
+		for _, vendor := range seed.Vendors {
+			if err := store.CreateVendor(vendor); err != nil {
+				store.log.Close()
+				return nil, err
+			}
+		}
+		for _, product := range seed.Products {
+			if _, err := store.PutProduct(product); err != nil {
+				store.log.Close()
+				return nil, err
+			}
+		}
+	}
+	return store, nil
+}
+
+func (f *FileStore) apply(record walRecord) error {
+	switch record.Op {
+	case opPutProduct:
+		if record.IdempotencyKey != "" {
+			_, _, err := f.mem.putProductOnce(record.IdempotencyKey, *record.Product, *record.Time)
+			return err
+		}
+		_, err := f.mem.PutProduct(*record.Product)
+		return err
+	case opUpdateProduct:
+		if record.IdempotencyKey != "" {
+			return f.mem.updateProductOnce(record.IdempotencyKey, *record.Product, *record.Time)
+		}
+		return f.mem.UpdateProduct(*record.Product)
+	case opDeleteProduct:
+		return f.mem.DeleteProduct(record.Product.ID)
+	case opCreateVendor:
+		return f.mem.CreateVendor(*record.Vendor)
+	case opUpdateVendor:
+		return f.mem.UpdateVendor(*record.Vendor)
+	case opDeleteVendor:
+		return f.mem.DeleteVendor(record.Vendor.Name)
+	case opReplace:
+		return f.mem.ReplaceCatalog(*record.Catalog)
+	default:
+		return fmt.Errorf("unknown log operation %q", record.Op)
+	}
+}
+
+// mutate logs and applies one mutation. It must be called with f.mu held and
+// only for mutations known to succeed, or replaying the log would fail.
+func (f *FileStore) mutate(record walRecord) error {
+	record.Seq = f.seq + 1
+	if err := f.log.append(record); err != nil {
+		return err
+	}
+	f.seq = record.Seq
+	if err := f.apply(record); err != nil {
+		return err
+	}
+	f.pending++
+	if f.pending >= snapshotEvery {
+		if err := f.snapshot(); err != nil {
+			log.Printf("could not snapshot catalog: %v", err)
+		}
+	}
+	return nil
+}
+
+// snapshot must be called with f.mu held.
+func (f *FileStore) snapshot() error {
+	snap := snapshot{Seq: f.seq, Catalog: f.mem.catalog(), Puts: f.mem.idempotentPuts()}
+	data, err := json.Marshal(snap)
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(filepath.Join(f.dir, snapshotFile), data); err != nil {
+		return err
+	}
+	f.pending = 0
+	return f.log.truncate()
+}
+
+func (f *FileStore) ListVendors() ([]Vendor, error) {
+	return f.mem.ListVendors()
+}
+
+func (f *FileStore) GetVendor(name string) (Vendor, error) {
+	return f.mem.GetVendor(name)
+}
+
+func (f *FileStore) CreateVendor(vendor Vendor) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, err := f.mem.GetVendor(vendor.Name); err == nil {
+		return ErrVendorExists
+	}
+	return f.mutate(walRecord{Op: opCreateVendor, Vendor: &vendor})
+}
+
+func (f *FileStore) UpdateVendor(vendor Vendor) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	current, err := f.mem.GetVendor(vendor.Name)
+	if err != nil {
+		return err
+	}
+	if len(vendor.ProductTypes) > 0 {
+		kept := make(map[string]bool, len(vendor.ProductTypes))
+		for _, prodType := range vendor.ProductTypes {
+			kept[prodType] = true
+		}
+		for _, prodType := range current.ProductTypes {
+			products, _ := f.mem.ListProducts(vendor.Name, prodType)
+			if !kept[prodType] && len(products) > 0 {
+				return ErrProductTypeNotEmpty
+			}
+		}
+	}
+	return f.mutate(walRecord{Op: opUpdateVendor, Vendor: &vendor})
+}
+
+func (f *FileStore) DeleteVendor(name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, err := f.mem.GetVendor(name); err != nil {
+		return err
+	}
+	return f.mutate(walRecord{Op: opDeleteVendor, Vendor: &Vendor{Name: name}})
+}
+
+func (f *FileStore) ListProductTypes(vendor string) ([]string, error) {
+	return f.mem.ListProductTypes(vendor)
+}
+
+func (f *FileStore) ListProducts(vendor, productType string) ([]Product, error) {
+	return f.mem.ListProducts(vendor, productType)
+}
+
+func (f *FileStore) GetProduct(id string) (Product, error) {
+	return f.mem.GetProduct(id)
+}
+
+func (f *FileStore) PutProduct(product Product) (Product, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	// the ID is assigned before logging so replays restore the same one
+	if product.ID == "" {
+		product.ID = newProductID()
+	}
+	if err := f.mutate(walRecord{Op: opPutProduct, Product: &product}); err != nil {
+		return Product{}, err
+	}
+	return product, nil
+}
+
+func (f *FileStore) PutProductOnce(key string, product Product) (Product, bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if stored, found, err := f.mem.PreviousPut(key, product); found || err != nil {
+		return stored, found, err
+	}
+	now := time.Now()
+	if product.ID == "" {
+		product.ID = newProductID()
+	}
+	record := walRecord{Op: opPutProduct, Product: &product, IdempotencyKey: key, Time: &now}
+	if err := f.mutate(record); err != nil {
+		return Product{}, false, err
+	}
+	return product, false, nil
+}
+
+func (f *FileStore) PreviousPut(key string, product Product) (Product, bool, error) {
+	return f.mem.PreviousPut(key, product)
+}
+
+func (f *FileStore) UpdateProduct(product Product) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, err := f.mem.GetProduct(product.ID); err != nil {
+		return err
+	}
+	return f.mutate(walRecord{Op: opUpdateProduct, Product: &product})
+}
+
+func (f *FileStore) UpdateProductOnce(key string, product Product) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, err := f.mem.GetProduct(product.ID); err != nil {
+		return err
+	}
+	if _, found, err := f.mem.PreviousPut(key, product); found || err != nil {
+		return err
+	}
+	now := time.Now()
+	return f.mutate(walRecord{Op: opUpdateProduct, Product: &product, IdempotencyKey: key, Time: &now})
+}
+
+func (f *FileStore) DeleteProduct(id string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	product, err := f.mem.GetProduct(id)
+	if err != nil {
+		return err
+	}
+	return f.mutate(walRecord{Op: opDeleteProduct, Product: &product})
+}
+
+// ReplaceCatalog logs the whole catalog as a single record, then snapshots
+// it right away so the log does not keep it.
+func (f *FileStore) ReplaceCatalog(catalog Catalog) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.mutate(walRecord{Op: opReplace, Catalog: &catalog}); err != nil {
+		return err
+	}
+	if err := f.snapshot(); err != nil {
+		log.Printf("could not snapshot catalog: %v", err)
+	}
+	return nil
+}
+
+// Close snapshots the catalog and releases the log file.
+func (f *FileStore) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.pending > 0 {
+		if err := f.snapshot(); err != nil {
+			f.log.Close()
+			return err
+		}
+	}
+	return f.log.Close()
+}
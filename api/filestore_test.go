@@ -0,0 +1,182 @@
+package api
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+var testCatalog = Catalog{
+	Vendors: []Vendor{
+		{Name: "aws", DisplayName: "Amazon Web Services", Enabled: true, ProductTypes: []string{"compute", "storage"}},
+	},
+	Products: []Product{
+		{Vendor: "aws", ProductType: "storage", Title: "Amazon RDS", URL: "https://aws.amazon.com/rds"},
+	},
+}
+
+// crash releases the log of store without snapshotting it, as if the server
+// was killed.
+func crash(t *testing.T, store *FileStore) {
+	t.Helper()
+	if err := store.log.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func openTestStore(t *testing.T, dir string) *FileStore {
+	t.Helper()
+	store, err := OpenFileStore(dir, testCatalog)
+	if err != nil {
+		t.Fatalf("OpenFileStore() = %v", err)
+	}
+	return store
+}
+
+// mutateTestStore applies one mutation of every kind to store.
+func mutateTestStore(t *testing.T, store *FileStore) {
+	t.Helper()
+	s3, err := store.PutProduct(Product{Vendor: "aws", ProductType: "storage", Title: "Amazon S3", URL: "https://aws.amazon.com/s3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s3.URL = "https://aws.amazon.com/s3/"
+	if err := store.UpdateProduct(s3); err != nil {
+		t.Fatal(err)
+	}
+	ecs, err := store.PutProduct(Product{Vendor: "aws", ProductType: "compute", Title: "ECS", URL: "https://aws.amazon.com/ecs"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.DeleteProduct(ecs.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.CreateVendor(Vendor{Name: "oracle", DisplayName: "Oracle Cloud", ProductTypes: []string{"compute"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.UpdateVendor(Vendor{Name: "oracle", DisplayName: "Oracle", Enabled: true}); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := store.PutProductOnce("key", Product{Vendor: "oracle", ProductType: "compute", Title: "VM", URL: "https://oracle.com/vm"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFileStoreReopen(t *testing.T) {
+	tests := []struct {
+		name  string
+		close func(*testing.T, *FileStore)
+	}{
+		{
+			name:  "crash",
+			close: crash,
+		},
+		{
+			name: "close",
+			close: func(t *testing.T, store *FileStore) {
+				if err := store.Close(); err != nil {
+					t.Fatal(err)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			store := openTestStore(t, dir)
+			mutateTestStore(t, store)
+			want := store.mem.catalog()
+			tt.close(t, store)
+
+			store = openTestStore(t, dir)
+			defer store.Close()
+			if got := store.mem.catalog(); !reflect.DeepEqual(got, want) {
+				t.Fatalf("reopened catalog = %+v, want %+v", got, want)
+			}
+			product, replayed, err := store.PutProductOnce("key", Product{Vendor: "oracle", ProductType: "compute", Title: "VM", URL: "https://oracle.com/vm"})
+			if err != nil || !replayed {
+				t.Fatalf("PutProductOnce() of a stored key = %v, %v, %v, want it replayed", product, replayed, err)
+			}
+		})
+	}
+}
+
+func TestFileStoreSkipsRecordsCoveredBySnapshot(t *testing.T) {
+	dir := t.TempDir()
+	store := openTestStore(t, dir)
+	mutateTestStore(t, store)
+	want := store.mem.catalog()
+	logged, err := ioutil.ReadFile(filepath.Join(dir, walFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+	// a crash between writing the snapshot and truncating the log leaves
+	// records the snapshot already holds
+	if err := ioutil.WriteFile(filepath.Join(dir, walFile), logged, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	store = openTestStore(t, dir)
+	defer store.Close()
+	if got := store.mem.catalog(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("reopened catalog = %+v, want %+v", got, want)
+	}
+	if store.pending != 0 {
+		t.Fatalf("replayed %d records the snapshot already holds", store.pending)
+	}
+}
+
+func TestFileStoreDoesNotReseed(t *testing.T) {
+	dir := t.TempDir()
+	store := openTestStore(t, dir)
+	products, err := store.ListProducts("aws", "storage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.DeleteProduct(products[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	crash(t, store)
+
+	store = openTestStore(t, dir)
+	defer store.Close()
+	if products, _ := store.ListProducts("aws", "storage"); len(products) != 0 {
+		t.Fatalf("reopened store lists %v, want the deleted seed product to stay deleted", products)
+	}
+}
+
+func TestFileStoreIdempotencyKeys(t *testing.T) {
+	dir := t.TempDir()
+	store := openTestStore(t, dir)
+	s3 := Product{Vendor: "aws", ProductType: "storage", Title: "Amazon S3", URL: "https://aws.amazon.com/s3"}
+	deleted, _, err := store.PutProductOnce("s3", s3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.DeleteProduct(deleted.ID); err != nil {
+		t.Fatal(err)
+	}
+	products, _ := store.ListProducts("aws", "storage")
+	rds := products[0]
+	rds.URL = "https://aws.amazon.com/rds/"
+	if err := store.UpdateProductOnce("rds", rds); err != nil {
+		t.Fatal(err)
+	}
+	crash(t, store)
+
+	store = openTestStore(t, dir)
+	defer store.Close()
+	stored, replayed, err := store.PutProductOnce("s3", s3)
+	if err != nil || replayed || stored.ID == deleted.ID {
+		t.Fatalf("PutProductOnce() of a deleted product = %v, %v, %v, want it stored again", stored, replayed, err)
+	}
+	stored, found, err := store.PreviousPut("rds", rds)
+	if err != nil || !found || stored != rds {
+		t.Fatalf("PreviousPut() of an updated product = %v, %v, %v, want %v", stored, found, err, rds)
+	}
+}
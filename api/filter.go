@@ -0,0 +1,328 @@
+package api
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// The filter language of SearchProducts, for example
+//
+//	vendor = "aws" AND (title : "Amazon*" OR NOT productType = "compute")
+//
+// A comparison is a field, an operator and a value, either quoted or a bare
+// word. "=" and "!=" compare whole values exactly, ":" matches values case
+// insensitively, as a substring or, if the value holds "*" wildcards, as a
+// pattern covering the whole field. Comparisons combine with NOT, AND and OR,
+// in decreasing order of precedence, and parentheses.
+
+// productFields lists the fields filters and orderings may refer to.
+var productFields = map[string]func(Product) string{
+	"id":          func(p Product) string { return p.ID },
+	"vendor":      func(p Product) string { return p.Vendor },
+	"productType": func(p Product) string { return p.ProductType },
+	"title":       func(p Product) string { return p.Title },
+	"url":         func(p Product) string { return p.URL },
+}
+
+// productFilter reports whether a product matches a filter.
+type productFilter func(Product) bool
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokWord
+	tokString
+	tokLParen
+	tokRParen
+	tokEq
+	tokNe
+	tokHas
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func lexFilter(input string) ([]token, error) {
+	var tokens []token
+	runes := []rune(input)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{kind: tokLParen, text: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokRParen, text: ")", pos: i})
+			i++
+		case r == '=':
+			tokens = append(tokens, token{kind: tokEq, text: "=", pos: i})
+			i++
+		case r == ':':
+			tokens = append(tokens, token{kind: tokHas, text: ":", pos: i})
+			i++
+		case r == '!':
+			if i+1 >= len(runes) || runes[i+1] != '=' {
+				return nil, fmt.Errorf("unexpected %q at position %d", r, i)
+			}
+			tokens = append(tokens, token{kind: tokNe, text: "!=", pos: i})
+			i += 2
+		case r == '"':
+			start := i
+			var value strings.Builder
+			for i++; ; i++ {
+				if i >= len(runes) {
+					return nil, fmt.Errorf("unterminated string at position %d", start)
+				}
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+					value.WriteRune(runes[i])
+					continue
+				}
+				if runes[i] == '"' {
+					i++
+					break
+				}
+				value.WriteRune(runes[i])
+			}
+			tokens = append(tokens, token{kind: tokString, text: value.String(), pos: start})
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune(`()=:!"`, runes[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokWord, text: string(runes[start:i]), pos: start})
+		}
+	}
+	return append(tokens, token{kind: tokEOF, pos: len(runes)}), nil
+}
+
+type filterParser struct {
+	tokens []token
+	pos    int
+}
+
+// parseFilter compiles a filter expression. An empty filter matches every
+// product.
+func parseFilter(input string) (productFilter, error) {
+	tokens, err := lexFilter(input)
+	if err != nil {
+		return nil, err
+	}
+	if tokens[0].kind == tokEOF {
+		return func(Product) bool { return true }, nil
+	}
+
+	p := &filterParser{tokens: tokens}
+	filter, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if next := p.peek(); next.kind != tokEOF {
+		return nil, fmt.Errorf("unexpected %q at position %d", next.text, next.pos)
+	}
+	return filter, nil
+}
+
+func (p *filterParser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *filterParser) keyword(word string) bool {
+	tok := p.peek()
+	if tok.kind == tokWord && tok.text == word {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *filterParser) parseOr() (productFilter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("OR") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(product Product) bool { return l(product) || right(product) }
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (productFilter, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("AND") {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(product Product) bool { return l(product) && right(product) }
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseNot() (productFilter, error) {
+	if p.keyword("NOT") {
+		inner, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return func(product Product) bool { return !inner(product) }, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *filterParser) parsePrimary() (productFilter, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokLParen:
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokRParen {
+			return nil, fmt.Errorf("expected ) at position %d", closing.pos)
+		}
+		return inner, nil
+	case tokWord:
+		return p.parseComparison(tok)
+	case tokEOF:
+		return nil, fmt.Errorf("unexpected end of filter")
+	default:
+		return nil, fmt.Errorf("unexpected %q at position %d", tok.text, tok.pos)
+	}
+}
+
+func (p *filterParser) parseComparison(field token) (productFilter, error) {
+	get, found := productFields[field.text]
+	if !found {
+		return nil, fmt.Errorf("unknown field %q at position %d, use one of %s", field.text, field.pos, strings.Join(fieldNames(), ", "))
+	}
+
+	op := p.next()
+	if op.kind != tokEq && op.kind != tokNe && op.kind != tokHas {
+		return nil, fmt.Errorf("expected =, != or : after %s at position %d", field.text, op.pos)
+	}
+	value := p.next()
+	if value.kind != tokString && value.kind != tokWord {
+		return nil, fmt.Errorf("expected a value after %s%s at position %d", field.text, op.text, value.pos)
+	}
+
+	want := value.text
+	switch op.kind {
+	case tokEq:
+		return func(product Product) bool { return get(product) == want }, nil
+	case tokNe:
+		return func(product Product) bool { return get(product) != want }, nil
+	default:
+		pattern := strings.ToLower(want)
+		if !strings.Contains(pattern, "*") {
+			return func(product Product) bool {
+				return strings.Contains(strings.ToLower(get(product)), pattern)
+			}, nil
+		}
+		return func(product Product) bool {
+			return matchWildcard(pattern, strings.ToLower(get(product)))
+		}, nil
+	}
+}
+
+// matchWildcard reports whether s matches pattern as a whole, "*" standing
+// for any run of characters.
+func matchWildcard(pattern, s string) bool {
+	parts := strings.Split(pattern, "*")
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+	for i, part := range parts[1:] {
+		if i == len(parts)-2 {
+			return strings.HasSuffix(s, part)
+		}
+		idx := strings.Index(s, part)
+		if idx < 0 {
+			return false
+		}
+		s = s[idx+len(part):]
+	}
+	return s == ""
+}
+
+// parseOrderBy compiles an ordering clause such as "vendor, title desc" into
+// a less function. An empty clause keeps the catalog order.
+func parseOrderBy(input string) (func(a, b Product) bool, error) {
+	type key struct {
+		get  func(Product) string
+		desc bool
+	}
+	var keys []key
+	for _, clause := range strings.Split(input, ",") {
+		words := strings.Fields(clause)
+		if len(words) == 0 {
+			if strings.TrimSpace(input) == "" {
+				break
+			}
+			return nil, fmt.Errorf("empty ordering clause in %q", input)
+		}
+		get, found := productFields[words[0]]
+		if !found {
+			return nil, fmt.Errorf("cannot order by unknown field %q, use one of %s", words[0], strings.Join(fieldNames(), ", "))
+		}
+		k := key{get: get}
+		switch {
+		case len(words) == 1:
+		case len(words) == 2 && strings.EqualFold(words[1], "asc"):
+		case len(words) == 2 && strings.EqualFold(words[1], "desc"):
+			k.desc = true
+		default:
+			return nil, fmt.Errorf("invalid ordering clause %q", strings.TrimSpace(clause))
+		}
+		keys = append(keys, k)
+	}
+
+	return func(a, b Product) bool {
+		for _, k := range keys {
+			va, vb := strings.ToLower(k.get(a)), strings.ToLower(k.get(b))
+			if va == vb {
+				continue
+			}
+			if k.desc {
+				return va > vb
+			}
+			return va < vb
+		}
+		return false
+	}, nil
+}
+
+func fieldNames() []string {
+	names := make([]string, 0, len(productFields))
+	for name := range productFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
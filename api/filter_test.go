@@ -0,0 +1,157 @@
+package api
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestMatchWildcard(t *testing.T) {
+	tests := []struct {
+		pattern string
+		s       string
+		want    bool
+	}{
+		{"*", "", true},
+		{"*", "anything", true},
+		{"**", "anything", true},
+		{"amazon*", "amazon rds", true},
+		{"amazon*", "amazon", true},
+		{"amazon*", "the amazon", false},
+		{"*rds", "amazon rds", true},
+		{"*rds", "rds proxy", false},
+		{"a*a", "a", false},
+		{"a*a", "aa", true},
+		{"a*a", "aba", true},
+		{"ab*ba", "aba", false},
+		{"ab*ba", "abba", true},
+		{"a*b*c", "abc", true},
+		{"a*b*c", "axbxc", true},
+		{"a*b*c", "acb", false},
+		{"*a*", "bab", true},
+		{"*a*", "bbb", false},
+		{"*ab*b", "ab", false},
+		{"*ab*b", "abb", true},
+	}
+
+	for _, tt := range tests {
+		if got := matchWildcard(tt.pattern, tt.s); got != tt.want {
+			t.Errorf("matchWildcard(%q, %q) = %v, want %v", tt.pattern, tt.s, got, tt.want)
+		}
+	}
+}
+
+var filterProducts = []Product{
+	{ID: "1", Vendor: "aws", ProductType: "storage", Title: "Amazon RDS", URL: "https://aws.amazon.com/rds"},
+	{ID: "2", Vendor: "aws", ProductType: "compute", Title: "AWS Fargate", URL: "https://aws.amazon.com/fargate"},
+	{ID: "3", Vendor: "google", ProductType: "compute", Title: "Cloud Run", URL: "https://cloud.google.com/run"},
+	{ID: "4", Vendor: "oracle", ProductType: "storage", Title: `Oracle "ZFS"`, URL: "https://www.oracle.com/storage/nas"},
+}
+
+func TestParseFilter(t *testing.T) {
+	tests := []struct {
+		filter string
+		want   []string
+	}{
+		{``, []string{"1", "2", "3", "4"}},
+		{`vendor = "aws"`, []string{"1", "2"}},
+		{`vendor = aws`, []string{"1", "2"}},
+		{`vendor != aws`, []string{"3", "4"}},
+		{`vendor = "AWS"`, nil},
+		{`title : "amazon"`, []string{"1"}},
+		{`title : "AMAZON*"`, []string{"1"}},
+		{`title : "*run"`, []string{"3"}},
+		{`title : "*"`, []string{"1", "2", "3", "4"}},
+		{`title : "Oracle \"ZFS\""`, []string{"4"}},
+		{`NOT productType = compute`, []string{"1", "4"}},
+		{`NOT NOT productType = compute`, []string{"2", "3"}},
+		{`vendor = aws AND productType = compute`, []string{"2"}},
+		{`vendor = google OR vendor = oracle`, []string{"3", "4"}},
+		// AND binds tighter than OR
+		{`vendor = google OR vendor = aws AND productType = storage`, []string{"1", "3"}},
+		{`(vendor = google OR vendor = aws) AND productType = storage`, []string{"1"}},
+		{`vendor = "aws" AND (title : "Amazon*" OR NOT productType = "compute")`, []string{"1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			filter, err := parseFilter(tt.filter)
+			if err != nil {
+				t.Fatalf("parseFilter() = %v", err)
+			}
+			var got []string
+			for _, product := range filterProducts {
+				if filter(product) {
+					got = append(got, product.ID)
+				}
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("matched %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("matched %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseFilterErrors(t *testing.T) {
+	tests := []string{
+		`vendor`,
+		`vendor =`,
+		`vendor = aws AND`,
+		`vendor = aws OR OR vendor = google`,
+		`price = 3`,
+		`vendor ! aws`,
+		`vendor = "aws`,
+		`(vendor = aws`,
+		`vendor = aws)`,
+		`vendor = aws productType = compute`,
+		`= aws`,
+		`vendor = (aws)`,
+	}
+
+	for _, filter := range tests {
+		t.Run(filter, func(t *testing.T) {
+			if _, err := parseFilter(filter); err == nil {
+				t.Fatalf("parseFilter(%q) succeeded, want an error", filter)
+			}
+		})
+	}
+}
+
+func TestParseOrderBy(t *testing.T) {
+	tests := []struct {
+		orderBy string
+		want    []string
+	}{
+		{``, []string{"1", "2", "3", "4"}},
+		{`vendor`, []string{"1", "2", "3", "4"}},
+		{`vendor desc`, []string{"4", "3", "1", "2"}},
+		{`productType, title`, []string{"2", "3", "1", "4"}},
+		{`productType DESC, title asc`, []string{"1", "4", "2", "3"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.orderBy, func(t *testing.T) {
+			less, err := parseOrderBy(tt.orderBy)
+			if err != nil {
+				t.Fatalf("parseOrderBy() = %v", err)
+			}
+			products := append([]Product(nil), filterProducts...)
+			sort.SliceStable(products, func(i, j int) bool { return less(products[i], products[j]) })
+			for i, product := range products {
+				if product.ID != tt.want[i] {
+					t.Fatalf("ordered %v, want %v", products, tt.want)
+				}
+			}
+		})
+	}
+
+	for _, orderBy := range []string{`price`, `vendor sideways`, `vendor,`, `vendor asc desc`} {
+		if _, err := parseOrderBy(orderBy); err == nil {
+			t.Errorf("parseOrderBy(%q) succeeded, want an error", orderBy)
+		}
+	}
+}
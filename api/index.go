@@ -0,0 +1,266 @@
+package api
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// BM25 parameters.
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// Weights of the ways an indexed term can match a query term.
+const (
+	exactWeight  = 1.0
+	prefixWeight = 0.8
+	typoWeight   = 0.6
+)
+
+// textIndex is an inverted index over product titles supporting prefix and
+// typo tolerant lookups ranked with BM25.
+type textIndex struct {
+	mu       sync.RWMutex
+	postings map[string]map[string]int // term -> product ID -> term frequency
+	docs     map[string]indexedDoc     // product ID -> indexed product
+	totalLen int
+	// vocabulary is the sorted list of terms, rebuilt on demand once
+	// postings changed
+	vocabulary []string
+	dirty      bool
+}
+
+type indexedDoc struct {
+	product Product
+	terms   []string
+}
+
+// searchHit is a product matching a query with its relevance score and the
+// indexed terms it matched through.
+type searchHit struct {
+	product Product
+	score   float64
+	terms   []string
+}
+
+func newTextIndex() *textIndex {
+	return &textIndex{
+		postings: make(map[string]map[string]int),
+		docs:     make(map[string]indexedDoc),
+	}
+}
+
+// tokenize splits s into lower-cased words of letters and digits.
+func tokenize(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// add indexes product, replacing the previous version of it if any.
+func (idx *textIndex) add(product Product) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.removeLocked(product.ID)
+
+	terms := tokenize(product.Title)
+	idx.docs[product.ID] = indexedDoc{product: product, terms: terms}
+	idx.totalLen += len(terms)
+	for _, term := range terms {
+		if idx.postings[term] == nil {
+			idx.postings[term] = make(map[string]int)
+			idx.dirty = true
+		}
+		idx.postings[term][product.ID]++
+	}
+}
+
+func (idx *textIndex) remove(id string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.removeLocked(id)
+}
+
+func (idx *textIndex) removeLocked(id string) {
+	doc, found := idx.docs[id]
+	if !found {
+		return
+	}
+	for _, term := range doc.terms {
+		delete(idx.postings[term], id)
+		if len(idx.postings[term]) == 0 {
+			delete(idx.postings, term)
+			idx.dirty = true
+		}
+	}
+	idx.totalLen -= len(doc.terms)
+	delete(idx.docs, id)
+}
+
+// search returns the products matching query, best first. Every query term
+// contributes the score of its best matching indexed term: the term itself, a
+// term it is a prefix of, or a term a few typos away.
+func (idx *textIndex) search(query string) []searchHit {
+	queryTerms := tokenize(query)
+	if len(queryTerms) == 0 {
+		return nil
+	}
+
+	idx.mu.Lock()
+	if idx.dirty {
+		idx.vocabulary = idx.vocabulary[:0]
+		for term := range idx.postings {
+			idx.vocabulary = append(idx.vocabulary, term)
+		}
+		sort.Strings(idx.vocabulary)
+		idx.dirty = false
+	}
+	idx.mu.Unlock()
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	if len(idx.docs) == 0 {
+		return nil
+	}
+	avgLen := float64(idx.totalLen) / float64(len(idx.docs))
+
+	hits := make(map[string]*searchHit)
+	for _, queryTerm := range queryTerms {
+		best := make(map[string]float64)
+		bestTerm := make(map[string]string)
+		for term, weight := range idx.expand(queryTerm) {
+			postings := idx.postings[term]
+			df := float64(len(postings))
+			idf := math.Log(1 + (float64(len(idx.docs))-df+0.5)/(df+0.5))
+			for id, tf := range postings {
+				docLen := float64(len(idx.docs[id].terms))
+				score := weight * idf * float64(tf) * (bm25K1 + 1) / (float64(tf) + bm25K1*(1-bm25B+bm25B*docLen/avgLen))
+				if score > best[id] {
+					best[id] = score
+					bestTerm[id] = term
+				}
+			}
+		}
+		for id, score := range best {
+			hit, found := hits[id]
+			if !found {
+				hit = &searchHit{product: idx.docs[id].product}
+				hits[id] = hit
+			}
+			hit.score += score
+			hit.terms = append(hit.terms, bestTerm[id])
+		}
+	}
+
+	ranked := make([]searchHit, 0, len(hits))
+	for _, hit := range hits {
+		ranked = append(ranked, *hit)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].score != ranked[j].score {
+			return ranked[i].score > ranked[j].score
+		}
+		return ranked[i].product.ID < ranked[j].product.ID
+	})
+	return ranked
+}
+
+// expand returns the indexed terms matching queryTerm with their weight. It
+// must be called with idx.mu held.
+func (idx *textIndex) expand(queryTerm string) map[string]float64 {
+	matches := make(map[string]float64)
+	if _, found := idx.postings[queryTerm]; found {
+		matches[queryTerm] = exactWeight
+	}
+
+	// prefixes of a single letter would match too much of the vocabulary
+	if len([]rune(queryTerm)) >= 2 {
+		start := sort.SearchStrings(idx.vocabulary, queryTerm)
+		for _, term := range idx.vocabulary[start:] {
+			if !strings.HasPrefix(term, queryTerm) {
+				break
+			}
+			if _, found := matches[term]; !found {
+				matches[term] = prefixWeight
+			}
+		}
+	}
+
+	maxTypos := allowedTypos(queryTerm)
+	if maxTypos == 0 {
+		return matches
+	}
+	for _, term := range idx.vocabulary {
+		if _, found := matches[term]; found {
+			continue
+		}
+		if typos := editDistance(queryTerm, term, maxTypos); typos <= maxTypos {
+			matches[term] = typoWeight / float64(typos)
+		}
+	}
+	return matches
+}
+
+// allowedTypos returns how many edits a query term may be away from an
+// indexed term, short terms having to match exactly.
+func allowedTypos(term string) int {
+	switch n := len([]rune(term)); {
+	case n < 4:
+		return 0
+	case n < 8:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// editDistance returns the Damerau-Levenshtein (optimal string alignment)
+// distance between a and b, or max+1 as soon as it is known to exceed max.
+func editDistance(a, b string, max int) int {
+	ra, rb := []rune(a), []rune(b)
+	if diff := len(ra) - len(rb); diff > max || -diff > max {
+		return max + 1
+	}
+
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		rowMin := cur[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				cur[j] = minInt(cur[j], prev2[j-2]+1)
+			}
+			if cur[j] < rowMin {
+				rowMin = cur[j]
+			}
+		}
+		if rowMin > max {
+			return max + 1
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(rb)]
+}
+
+func minInt(first int, rest ...int) int {
+	for _, n := range rest {
+		if n < first {
+			first = n
+		}
+	}
+	return first
+}
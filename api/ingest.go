@@ -0,0 +1,70 @@
+package api
+
+import (
+	"io"
+	"log"
+
+	pb "github.com/bharat-rajani/grpc-products-demo/gen/proto/products"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+// IngestProducts stores the products of the stream like SetVendorProducts,
+// but acknowledges every message with the assigned id or the reason it was
+// rejected instead of ending the stream on the first invalid product.
+// Clients should receive the results while sending, as acknowledgments are
+// sent as soon as each message is handled.
+func (pserv *ProductServer) IngestProducts(stream pb.ProductService_IngestProductsServer) error {
+	var index, stored int64
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			log.Printf("have ingested %d of %d products", stored, index)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := stream.Context().Err(); err != nil {
+			return contextError(err)
+		}
+
+		result := &pb.IngestResult{Index: index}
+		product, err := pserv.validateProduct(req)
+		if err == nil {
+			if product, err = pserv.addProductOnce(req.GetIdempotencyKey(), product); err != nil {
+				err = storeError(err)
+			}
+		}
+		if err != nil {
+			result.Result = &pb.IngestResult_Error{Error: ingestError(err)}
+		} else {
+			result.Result = &pb.IngestResult_Id{Id: product.ID}
+			stored++
+		}
+		if err := stream.Send(result); err != nil {
+			return err
+		}
+		index++
+	}
+}
+
+// ingestError converts the status err to its IngestResult form.
+func ingestError(err error) *pb.IngestError {
+	st := status.Convert(err)
+	ingestErr := &pb.IngestError{
+		Code:    int32(st.Code()),
+		Message: st.Message(),
+	}
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.GetFieldViolations() {
+				ingestErr.FieldViolations = append(ingestErr.FieldViolations, &pb.FieldViolation{
+					Field:       violation.GetField(),
+					Description: violation.GetDescription(),
+				})
+			}
+		}
+	}
+	return ingestErr
+}
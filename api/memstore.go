@@ -0,0 +1,431 @@
+package api
+
+import (
+	"sync"
+	"time"
+)
+
+var defaultVendors = []Vendor{
+	{Name: "google", DisplayName: "Google Cloud", Homepage: "https://cloud.google.com", Enabled: true, ProductTypes: []string{"compute", "storage"}},
+	{Name: "aws", DisplayName: "Amazon Web Services", Homepage: "https://aws.amazon.com", Enabled: true, ProductTypes: []string{"compute", "storage"}},
+	{Name: "oracle", DisplayName: "Oracle Cloud", Homepage: "https://www.oracle.com/cloud", Enabled: true, ProductTypes: []string{"compute", "storage"}},
+}
+
+var defaultProducts = []Product{
+	{Vendor: "google", ProductType: "compute", Title: "App Engine", URL: "https://cloud.google.com/appengine"},
+	{Vendor: "google", ProductType: "compute", Title: "Cloud Run", URL: "https://cloud.google.com/run"},
+	{Vendor: "google", ProductType: "storage", Title: "Cloud Storage", URL: "https://cloud.google.com/storage"},
+	{Vendor: "google", ProductType: "storage", Title: "Filestore", URL: "https://cloud.google.com/filestore"},
+	{Vendor: "aws", ProductType: "compute", Title: "ECS", URL: "https://aws.amazon.com/ecs"},
+	{Vendor: "aws", ProductType: "compute", Title: "EKR", URL: "https://aws.amazon.com/ecr"},
+	{Vendor: "aws", ProductType: "compute", Title: "AWS Fargate", URL: "https://aws.amazon.com/fargate"},
+	{Vendor: "aws", ProductType: "storage", Title: "Amazon Aurora", URL: "https://aws.amazon.com/rds/aurora"},
+	{Vendor: "aws", ProductType: "storage", Title: "Amazon RDS", URL: "https://aws.amazon.com/rds"},
+	{Vendor: "aws", ProductType: "storage", Title: "Amazon Redshift", URL: "https://aws.amazon.com/redshift"},
+	{Vendor: "oracle", ProductType: "compute", Title: "VM", URL: "https://www.oracle.com/cloud/compute/virtual-machines"},
+	{Vendor: "oracle", ProductType: "compute", Title: "Bare Metal", URL: "https://www.oracle.com/cloud/compute/bare-metal"},
+	{Vendor: "oracle", ProductType: "storage", Title: "Oracle ZFS", URL: "https://www.oracle.com/storage/nas"},
+	{Vendor: "oracle", ProductType: "storage", Title: "Oracle StorageTek", URL: "https://www.oracle.com/storage/tape-storage"},
+}
+
+// DefaultCatalog returns the vendors and products the demo server ships with.
+func DefaultCatalog() Catalog {
+	catalog := Catalog{Products: append([]Product(nil), defaultProducts...)}
+	for _, vendor := range defaultVendors {
+		catalog.Vendors = append(catalog.Vendors, vendor.clone())
+	}
+	return catalog
+}
+
+// MemoryStore is a ProductStore keeping the whole catalog in memory. Vendors,
+// product types and products are listed in insertion order.
+type MemoryStore struct {
+	mu       sync.RWMutex
+	vendors  []string
+	meta     map[string]Vendor
+	products map[string]map[string][]Product
+	byID     map[string]Product
+	// puts lists the products put with an idempotency key, oldest first
+	puts   []idempotentPut
+	putKey map[string]idempotentPut
+}
+
+// NewMemoryStore returns a MemoryStore seeded with catalog.
+func NewMemoryStore(catalog Catalog) *MemoryStore {
+	store := &MemoryStore{
+		meta:     make(map[string]Vendor),
+		products: make(map[string]map[string][]Product),
+		byID:     make(map[string]Product),
+		putKey:   make(map[string]idempotentPut),
+	}
+	for _, vendor := range catalog.Vendors {
+		store.CreateVendor(vendor)
+	}
+	for _, product := range catalog.Products {
+		store.PutProduct(product)
+	}
+	return store
+}
+
+func (m *MemoryStore) ListVendors() ([]Vendor, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	vendors := make([]Vendor, 0, len(m.vendors))
+	for _, name := range m.vendors {
+		vendors = append(vendors, m.meta[name].clone())
+	}
+	return vendors, nil
+}
+
+func (m *MemoryStore) GetVendor(name string) (Vendor, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	vendor, found := m.meta[name]
+	if !found {
+		return Vendor{}, ErrUnknownVendor
+	}
+	return vendor.clone(), nil
+}
+
+func (m *MemoryStore) CreateVendor(vendor Vendor) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, found := m.meta[vendor.Name]; found {
+		return ErrVendorExists
+	}
+	vendor = vendor.clone()
+	m.vendors = append(m.vendors, vendor.Name)
+	m.meta[vendor.Name] = vendor
+	m.products[vendor.Name] = make(map[string][]Product)
+	for _, prodType := range vendor.ProductTypes {
+		m.products[vendor.Name][prodType] = nil
+	}
+	return nil
+}
+
+// UpdateVendor keeps the current product types when vendor lists none.
+func (m *MemoryStore) UpdateVendor(vendor Vendor) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	current, found := m.meta[vendor.Name]
+	if !found {
+		return ErrUnknownVendor
+	}
+	vendor = vendor.clone()
+	if len(vendor.ProductTypes) == 0 {
+		vendor.ProductTypes = current.ProductTypes
+	}
+
+	kept := make(map[string]bool, len(vendor.ProductTypes))
+	for _, prodType := range vendor.ProductTypes {
+		kept[prodType] = true
+	}
+	vendorProducts := m.products[vendor.Name]
+	for prodType, products := range vendorProducts {
+		if !kept[prodType] && len(products) > 0 {
+			return ErrProductTypeNotEmpty
+		}
+	}
+	for prodType := range vendorProducts {
+		if !kept[prodType] {
+			delete(vendorProducts, prodType)
+		}
+	}
+	for prodType := range kept {
+		if _, found := vendorProducts[prodType]; !found {
+			vendorProducts[prodType] = nil
+		}
+	}
+	m.meta[vendor.Name] = vendor
+	return nil
+}
+
+// DeleteVendor removes the vendor together with all of its products.
+func (m *MemoryStore) DeleteVendor(name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, found := m.meta[name]; !found {
+		return ErrUnknownVendor
+	}
+	for _, products := range m.products[name] {
+		for _, product := range products {
+			delete(m.byID, product.ID)
+		}
+	}
+	delete(m.products, name)
+	delete(m.meta, name)
+	for i, vendor := range m.vendors {
+		if vendor == name {
+			m.vendors = append(m.vendors[:i:i], m.vendors[i+1:]...)
+			break
+		}
+	}
+	m.forgetPuts(m.stored)
+	return nil
+}
+
+func (m *MemoryStore) ListProductTypes(vendor string) ([]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	meta, found := m.meta[vendor]
+	if !found {
+		return nil, ErrUnknownVendor
+	}
+	return append([]string(nil), meta.ProductTypes...), nil
+}
+
+func (m *MemoryStore) ListProducts(vendor, productType string) ([]Product, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	vendorProducts, found := m.products[vendor]
+	if !found {
+		return nil, ErrUnknownVendor
+	}
+	products, found := vendorProducts[productType]
+	if !found {
+		return nil, ErrUnknownProductType
+	}
+	return append([]Product(nil), products...), nil
+}
+
+func (m *MemoryStore) GetProduct(id string) (Product, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	product, found := m.byID[id]
+	if !found {
+		return Product{}, ErrProductNotFound
+	}
+	return product, nil
+}
+
+// PutProduct keeps the ID of product if it has one, which lets a persistent
+// store wrapping a MemoryStore replay products with their original IDs.
+func (m *MemoryStore) PutProduct(product Product) (Product, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if product.ID == "" {
+		product.ID = newProductID()
+	}
+	m.insert(product)
+	return product, nil
+}
+
+func (m *MemoryStore) PutProductOnce(key string, product Product) (Product, bool, error) {
+	return m.putProductOnce(key, product, time.Now())
+}
+
+// putProductOnce is PutProductOnce at the given time, letting a persistent
+// store replay puts with their original time.
+func (m *MemoryStore) putProductOnce(key string, product Product, now time.Time) (Product, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if stored, found, err := m.previousPut(key, product, now); found || err != nil {
+		return stored, found, err
+	}
+	if product.ID == "" {
+		product.ID = newProductID()
+	}
+	m.insert(product)
+	m.rememberPut(idempotentPut{Key: key, Time: now, Product: product})
+	return product, false, nil
+}
+
+// rememberPut must be called with m.mu held.
+func (m *MemoryStore) rememberPut(put idempotentPut) {
+	m.puts = append(m.puts, put)
+	m.putKey[put.Key] = put
+}
+
+// forgetPuts must be called with m.mu held. It forgets the idempotency keys
+// of the puts keep rejects, so that retrying them stores them again.
+func (m *MemoryStore) forgetPuts(keep func(put idempotentPut) bool) {
+	puts := m.puts[:0]
+	for _, put := range m.puts {
+		if keep(put) {
+			puts = append(puts, put)
+		} else {
+			delete(m.putKey, put.Key)
+		}
+	}
+	m.puts = puts
+}
+
+// stored reports whether the product of put is still in the catalog. It must
+// be called with m.mu held.
+func (m *MemoryStore) stored(put idempotentPut) bool {
+	_, found := m.byID[put.Product.ID]
+	return found
+}
+
+// previousPut returns the product put with key within idempotencyWindow
+// before now, forgetting the expired keys. It must be called with m.mu held.
+func (m *MemoryStore) previousPut(key string, product Product, now time.Time) (Product, bool, error) {
+	expired := 0
+	for _, put := range m.puts {
+		if now.Sub(put.Time) < idempotencyWindow {
+			break
+		}
+		delete(m.putKey, put.Key)
+		expired++
+	}
+	m.puts = m.puts[expired:]
+
+	put, found := m.putKey[key]
+	if !found {
+		return Product{}, false, nil
+	}
+	if !put.Product.sameContent(product) {
+		return Product{}, false, ErrIdempotencyKeyReused
+	}
+	return put.Product, true, nil
+}
+
+func (m *MemoryStore) PreviousPut(key string, product Product) (Product, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.previousPut(key, product, time.Now())
+}
+
+// idempotentPuts returns the products put with an idempotency key that is
+// not expired yet, oldest first.
+func (m *MemoryStore) idempotentPuts() []idempotentPut {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]idempotentPut(nil), m.puts...)
+}
+
+// restorePuts remembers puts, as returned by idempotentPuts, without storing
+// their products again.
+func (m *MemoryStore) restorePuts(puts []idempotentPut) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, put := range puts {
+		m.puts = append(m.puts, put)
+		m.putKey[put.Key] = put
+	}
+}
+
+func (m *MemoryStore) UpdateProduct(product Product) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.update(product)
+}
+
+func (m *MemoryStore) UpdateProductOnce(key string, product Product) error {
+	return m.updateProductOnce(key, product, time.Now())
+}
+
+// updateProductOnce is UpdateProductOnce at the given time, letting a
+// persistent store replay updates with their original time.
+func (m *MemoryStore) updateProductOnce(key string, product Product, now time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, found, err := m.previousPut(key, product, now); found || err != nil {
+		return err
+	}
+	if err := m.update(product); err != nil {
+		return err
+	}
+	m.rememberPut(idempotentPut{Key: key, Time: now, Product: product})
+	return nil
+}
+
+// update must be called with m.mu held.
+func (m *MemoryStore) update(product Product) error {
+	current, found := m.byID[product.ID]
+	if !found {
+		return ErrProductNotFound
+	}
+	if current.Vendor == product.Vendor && current.ProductType == product.ProductType {
+		products := m.products[product.Vendor][product.ProductType]
+		for i := range products {
+			if products[i].ID == product.ID {
+				products[i] = product
+			}
+		}
+		m.byID[product.ID] = product
+		return nil
+	}
+	m.remove(current)
+	m.insert(product)
+	return nil
+}
+
+func (m *MemoryStore) DeleteProduct(id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	product, found := m.byID[id]
+	if !found {
+		return ErrProductNotFound
+	}
+	m.remove(product)
+	m.forgetPuts(m.stored)
+	return nil
+}
+
+// ReplaceCatalog forgets the idempotency keys of the products the new catalog
+// does not list unchanged, so that retrying them stores them again.
+func (m *MemoryStore) ReplaceCatalog(catalog Catalog) error {
+	replaced := NewMemoryStore(catalog)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.vendors = replaced.vendors
+	m.meta = replaced.meta
+	m.products = replaced.products
+	m.byID = replaced.byID
+	m.forgetPuts(func(put idempotentPut) bool {
+		return m.byID[put.Product.ID] == put.Product
+	})
+	return nil
+}
+
+// insert must be called with m.mu held. Unknown vendors are registered as
+// enabled with a display name derived from their name.
+func (m *MemoryStore) insert(product Product) {
+	vendorProducts, found := m.products[product.Vendor]
+	if !found {
+		vendorProducts = make(map[string][]Product)
+		m.products[product.Vendor] = vendorProducts
+		m.vendors = append(m.vendors, product.Vendor)
+		m.meta[product.Vendor] = Vendor{
+			Name:        product.Vendor,
+			DisplayName: titleCase(product.Vendor),
+			Enabled:     true,
+		}
+	}
+	if _, found := vendorProducts[product.ProductType]; !found {
+		meta := m.meta[product.Vendor]
+		meta.ProductTypes = append(meta.ProductTypes, product.ProductType)
+		m.meta[product.Vendor] = meta
+	}
+	vendorProducts[product.ProductType] = append(vendorProducts[product.ProductType], product)
+	m.byID[product.ID] = product
+}
+
+// remove must be called with m.mu held. The vendor and product type of the
+// product stay registered even when they are left empty.
+func (m *MemoryStore) remove(product Product) {
+	products := m.products[product.Vendor][product.ProductType]
+	for i := range products {
+		if products[i].ID == product.ID {
+			m.products[product.Vendor][product.ProductType] = append(products[:i:i], products[i+1:]...)
+			break
+		}
+	}
+	delete(m.byID, product.ID)
+}
+
+// catalog returns a copy of the whole catalog.
+func (m *MemoryStore) catalog() Catalog {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var catalog Catalog
+	for _, name := range m.vendors {
+		vendor := m.meta[name]
+		catalog.Vendors = append(catalog.Vendors, vendor.clone())
+		for _, prodType := range vendor.ProductTypes {
+			catalog.Products = append(catalog.Products, m.products[name][prodType]...)
+		}
+	}
+	return catalog
+}
@@ -0,0 +1,413 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	pb "github.com/bharat-rajani/grpc-products-demo/gen/proto/products"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	moderationLogFile = "moderation.wal"
+
+	// moderationLogSize is the number of moderation records kept for
+	// ListModerationLog. The log file keeps all of them for audit.
+	moderationLogSize = 1000
+	// rateBucketsPrune is the number of senders tracked by the rate limit
+	// past which the ones with a full bucket are forgotten.
+	rateBucketsPrune = 1000
+)
+
+// ErrKickedChatMember is reported by a chat member whose participant was
+// kicked from the room.
+var ErrKickedChatMember = errors.New("kicked from chat room")
+
+// ChatRules are the limits the text messages posted in the chat rooms are
+// checked against. A zero value disables a rule.
+type ChatRules struct {
+	// MessageRate is the number of messages per second a sender may post
+	// over time, across rooms. The typing events and read receipts of a
+	// sender are limited at the same rate, apart from its messages.
+	MessageRate float64
+	// MessageBurst is the number of messages a sender may post at once.
+	MessageBurst int
+	// MaxMessageLength is the maximum number of characters of a message.
+	MaxMessageLength int
+	// BlockedWords are the words messages may not contain, matched as whole
+	// words regardless of case.
+	BlockedWords []string
+}
+
+// moderationRecord is one moderation action in the moderation log.
+type moderationRecord struct {
+	Action    string     `json:"action"`
+	Room      string     `json:"room"`
+	Name      string     `json:"name"`
+	Moderator string     `json:"moderator"`
+	Reason    string     `json:"reason"`
+	Time      time.Time  `json:"time"`
+	Expires   *time.Time `json:"expires,omitempty"`
+}
+
+func (r moderationRecord) toProto() *pb.ModerationRecord {
+	record := &pb.ModerationRecord{
+		Action:    pb.ModerationAction(pb.ModerationAction_value[r.Action]),
+		Room:      r.Room,
+		Name:      r.Name,
+		Moderator: r.Moderator,
+		Reason:    r.Reason,
+		Timestamp: timestamppb.New(r.Time),
+	}
+	if r.Expires != nil {
+		record.Expires = timestamppb.New(*r.Expires)
+	}
+	return record
+}
+
+// expired reports whether a mute or kick ending at until is over; a zero
+// until never ends.
+func expired(until time.Time, now time.Time) bool {
+	return !until.IsZero() && !now.Before(until)
+}
+
+// roomSeat is a participant of a chat room.
+type roomSeat struct {
+	room string
+	name string
+}
+
+// tokenBucket rate limits the events of one sender.
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// ChatModerator checks the chat messages against the ChatRules and keeps the
+// mutes and kicks, optionally persisted in an append-only moderation log
+// that is replayed on open and kept for audit.
+type ChatModerator struct {
+	mu      sync.Mutex
+	rules   ChatRules
+	blocked map[string]bool
+	buckets map[string]*tokenBucket
+	signals map[string]*tokenBucket
+	// mutes and kicks map participants to when the action ends, zero if it
+	// does not
+	mutes   map[roomSeat]time.Time
+	kicks   map[roomSeat]time.Time
+	records []moderationRecord
+	// log persists the records, nil when kept in memory only
+	log *wal
+}
+
+// NewChatModerator returns a ChatModerator enforcing rules and keeping the
+// moderation log in memory.
+func NewChatModerator(rules ChatRules) *ChatModerator {
+	m := &ChatModerator{
+		rules:   rules,
+		blocked: make(map[string]bool),
+		buckets: make(map[string]*tokenBucket),
+		signals: make(map[string]*tokenBucket),
+		mutes:   make(map[roomSeat]time.Time),
+		kicks:   make(map[roomSeat]time.Time),
+	}
+	for _, word := range rules.BlockedWords {
+		if word = strings.ToLower(strings.TrimSpace(word)); word != "" {
+			m.blocked[word] = true
+		}
+	}
+	return m
+}
+
+// OpenChatModerator returns a ChatModerator enforcing rules and persisting
+// the moderation log in dir, creating dir if needed. The mutes and kicks of
+// the log that have not expired are enforced again.
+func OpenChatModerator(rules ChatRules, dir string) (*ChatModerator, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	m := NewChatModerator(rules)
+	path := filepath.Join(dir, moderationLogFile)
+	var err error
+	m.log, err = openWAL(path)
+	if err != nil {
+		return nil, err
+	}
+	err = m.log.replay(func(line json.RawMessage) error {
+		var record moderationRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return fmt.Errorf("decoding %s: %v", path, err)
+		}
+		m.apply(record)
+		return nil
+	})
+	if err != nil {
+		m.log.Close()
+		return nil, err
+	}
+	log.Printf("loaded %d moderation records from %s", len(m.records), path)
+	return m, nil
+}
+
+// apply must be called with m.mu held, or before m is shared.
+func (m *ChatModerator) apply(record moderationRecord) {
+	m.records = append(m.records, record)
+	if len(m.records) > moderationLogSize {
+		m.records = m.records[len(m.records)-moderationLogSize:]
+	}
+
+	seat := roomSeat{room: record.Room, name: record.Name}
+	var until time.Time
+	if record.Expires != nil {
+		until = *record.Expires
+	}
+	switch record.Action {
+	case pb.ModerationAction_MODERATION_ACTION_MUTE.String():
+		m.mutes[seat] = until
+	case pb.ModerationAction_MODERATION_ACTION_UNMUTE.String():
+		delete(m.mutes, seat)
+	case pb.ModerationAction_MODERATION_ACTION_KICK.String():
+		// a kick without duration only disconnects
+		if record.Expires != nil {
+			m.kicks[seat] = until
+		}
+	}
+}
+
+// record appends record to the moderation log, then applies it. Nothing is
+// applied if it could not be logged.
+func (m *ChatModerator) record(record moderationRecord) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.log != nil {
+		if err := m.log.append(record); err != nil {
+			return err
+		}
+	}
+	log.Printf("moderation: -> %s <- %s -> %s <- in the -> %s <- chat room: %s", record.Moderator, record.Action, record.Name, record.Room, record.Reason)
+	m.apply(record)
+	return nil
+}
+
+// muted reports until when name is muted in room, a zero time meaning until
+// unmuted.
+func (m *ChatModerator) muted(room, name string) (time.Time, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.active(m.mutes, roomSeat{room: room, name: name})
+}
+
+// kicked reports until when name may not rejoin room.
+func (m *ChatModerator) kicked(room, name string) (time.Time, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.active(m.kicks, roomSeat{room: room, name: name})
+}
+
+// active must be called with m.mu held. Expired actions are forgotten.
+func (m *ChatModerator) active(actions map[roomSeat]time.Time, seat roomSeat) (time.Time, bool) {
+	until, found := actions[seat]
+	if !found {
+		return time.Time{}, false
+	}
+	if expired(until, time.Now()) {
+		delete(actions, seat)
+		return time.Time{}, false
+	}
+	return until, true
+}
+
+// check returns why msg may not be posted. The typing events and read
+// receipts of muted participants, or sent too fast, are dropped without a
+// reason.
+func (m *ChatModerator) check(msg *pb.ChatMessage) (string, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	seat := roomSeat{room: msg.GetRoom(), name: msg.GetSender()}
+	if _, text := msg.GetEvent().(*pb.ChatMessage_MessageContent); !text {
+		_, muted := m.active(m.mutes, seat)
+		return "", !muted && m.take(m.signals, msg.GetSender(), time.Now())
+	}
+
+	if until, muted := m.active(m.mutes, seat); muted {
+		if until.IsZero() {
+			return "you are muted in this room", false
+		}
+		return fmt.Sprintf("you are muted in this room until %s", until.Format(time.RFC3339)), false
+	}
+	text := msg.GetMessageContent()
+	if max := m.rules.MaxMessageLength; max > 0 && utf8.RuneCountInString(text) > max {
+		return fmt.Sprintf("messages may not be longer than %d characters", max), false
+	}
+	if word, found := m.blockedWord(text); found {
+		return fmt.Sprintf("the word %q is not allowed", word), false
+	}
+	if !m.take(m.buckets, msg.GetSender(), time.Now()) {
+		return "you are posting too fast, slow down", false
+	}
+	return "", true
+}
+
+// blockedWord must be called with m.mu held.
+func (m *ChatModerator) blockedWord(text string) (string, bool) {
+	if len(m.blocked) == 0 {
+		return "", false
+	}
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		if m.blocked[strings.ToLower(word)] {
+			return word, true
+		}
+	}
+	return "", false
+}
+
+// take must be called with m.mu held. It reports whether sender may post
+// one more event at now, taking a token from its bucket in buckets.
+func (m *ChatModerator) take(buckets map[string]*tokenBucket, sender string, now time.Time) bool {
+	rate, burst := m.rules.MessageRate, float64(m.rules.MessageBurst)
+	if rate <= 0 {
+		return true
+	}
+	if burst < 1 {
+		burst = 1
+	}
+
+	if len(buckets) > rateBucketsPrune {
+		for name, bucket := range buckets {
+			if bucket.tokens+now.Sub(bucket.last).Seconds()*rate >= burst {
+				delete(buckets, name)
+			}
+		}
+	}
+	bucket, found := buckets[sender]
+	if !found {
+		bucket = &tokenBucket{tokens: burst, last: now}
+		buckets[sender] = bucket
+	}
+	bucket.tokens += now.Sub(bucket.last).Seconds() * rate
+	if bucket.tokens > burst {
+		bucket.tokens = burst
+	}
+	bucket.last = now
+	if bucket.tokens < 1 {
+		return false
+	}
+	bucket.tokens--
+	return true
+}
+
+// list returns the kept records of room, or of every room if room is empty,
+// oldest first.
+func (m *ChatModerator) list(room string) []*pb.ModerationRecord {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var records []*pb.ModerationRecord
+	for _, record := range m.records {
+		if room == "" || record.Room == room {
+			records = append(records, record.toProto())
+		}
+	}
+	return records
+}
+
+// Close closes the moderation log, if any.
+func (m *ChatModerator) Close() error {
+	if m.log == nil {
+		return nil
+	}
+	return m.log.Close()
+}
+
+// rejection returns the event telling the sender of msg why it was not
+// posted.
+func rejection(msg *pb.ChatMessage, reason string) *pb.ChatMessage {
+	event := newChatEvent(msg.GetRoom(), msg.GetSender())
+	event.Event = &pb.ChatMessage_Moderation{Moderation: &pb.ModerationRecord{
+		Action:    pb.ModerationAction_MODERATION_ACTION_REJECT,
+		Room:      msg.GetRoom(),
+		Name:      msg.GetSender(),
+		Reason:    reason,
+		Timestamp: event.GetTimestamp(),
+	}}
+	return event
+}
+
+// MuteChatParticipant rejects the messages of a participant of a chat room
+// for durationSeconds, or until unmuted.
+func (pserv *ProductServer) MuteChatParticipant(ctx context.Context, req *pb.ModerateChatRequest) (*pb.ModerationRecord, error) {
+	return pserv.moderate(pb.ModerationAction_MODERATION_ACTION_MUTE, req)
+}
+
+// UnmuteChatParticipant lifts the mute of a participant of a chat room.
+func (pserv *ProductServer) UnmuteChatParticipant(ctx context.Context, req *pb.ModerateChatRequest) (*pb.ModerationRecord, error) {
+	if _, muted := pserv.moderator.muted(req.GetRoom(), req.GetName()); !muted {
+		return nil, status.Errorf(codes.NotFound, "%s is not muted in the %s chat room", req.GetName(), req.GetRoom())
+	}
+	return pserv.moderate(pb.ModerationAction_MODERATION_ACTION_UNMUTE, req)
+}
+
+// KickChatParticipant disconnects a participant from a chat room and keeps it
+// from rejoining for durationSeconds.
+func (pserv *ProductServer) KickChatParticipant(ctx context.Context, req *pb.ModerateChatRequest) (*pb.ModerationRecord, error) {
+	return pserv.moderate(pb.ModerationAction_MODERATION_ACTION_KICK, req)
+}
+
+// moderate logs action and has ChatBotName announce it in the room.
+func (pserv *ProductServer) moderate(action pb.ModerationAction, req *pb.ModerateChatRequest) (*pb.ModerationRecord, error) {
+	log.Printf("have received a request to %s -> %s <- in the -> %s <- chat room", action, req.GetName(), req.GetRoom())
+	if err := pserv.checkVendor(req.GetRoom()); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.GetName()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "the participant name is required")
+	}
+	if req.GetDurationSeconds() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "durationSeconds cannot be negative")
+	}
+
+	record := moderationRecord{
+		Action:    action.String(),
+		Room:      req.GetRoom(),
+		Name:      req.GetName(),
+		Moderator: req.GetModerator(),
+		Reason:    req.GetReason(),
+		Time:      time.Now(),
+	}
+	if req.GetDurationSeconds() > 0 && action != pb.ModerationAction_MODERATION_ACTION_UNMUTE {
+		expires := record.Time.Add(time.Duration(req.GetDurationSeconds()) * time.Second)
+		record.Expires = &expires
+	}
+	if err := pserv.moderator.record(record); err != nil {
+		return nil, status.Errorf(codes.Internal, "could not log the moderation action: %v", err)
+	}
+
+	// announced by the bot, the moderator is named in the record
+	announcement := newChatEvent(record.Room, ChatBotName)
+	announcement.Event = &pb.ChatMessage_Moderation{Moderation: record.toProto()}
+	pserv.chat.post(announcement)
+	if action == pb.ModerationAction_MODERATION_ACTION_KICK {
+		pserv.chat.kick(record.Room, record.Name)
+	}
+	return record.toProto(), nil
+}
+
+// ListModerationLog lists the last moderation actions.
+func (pserv *ProductServer) ListModerationLog(ctx context.Context, req *pb.ListModerationLogRequest) (*pb.ListModerationLogResponse, error) {
+	return &pb.ListModerationLogResponse{Records: pserv.moderator.list(req.GetRoom())}, nil
+}
@@ -0,0 +1,155 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+
+	pb "github.com/bharat-rajani/grpc-products-demo/gen/proto/products"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 1000
+)
+
+var errInvalidPageToken = errors.New("invalid page token")
+
+// pageCursor is the position a page token resumes from. Pages are ordered by
+// product ID and resume after the last ID sent, so products inserted or
+// deleted between two pages never shift the following ones.
+type pageCursor struct {
+	Vendor      string `json:"v,omitempty"`
+	ProductType string `json:"t,omitempty"`
+	After       string `json:"a"`
+}
+
+// pageTokens signs page cursors so clients cannot forge or alter them.
+type pageTokens struct {
+	key []byte
+}
+
+// newPageTokens returns pageTokens signing with a random key, so tokens do not
+// outlive the server process.
+func newPageTokens() *pageTokens {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		panic(err)
+	}
+	return &pageTokens{key: key}
+}
+
+func (p *pageTokens) encode(cursor pageCursor) string {
+	payload, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(p.sign(payload))
+}
+
+func (p *pageTokens) decode(token string) (pageCursor, error) {
+	var cursor pageCursor
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return cursor, errInvalidPageToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return cursor, errInvalidPageToken
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(mac, p.sign(payload)) {
+		return cursor, errInvalidPageToken
+	}
+	if err := json.NewDecoder(bytes.NewReader(payload)).Decode(&cursor); err != nil {
+		return cursor, errInvalidPageToken
+	}
+	return cursor, nil
+}
+
+func (p *pageTokens) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, p.key)
+	mac.Write(payload)
+	return mac.Sum(nil)[:16]
+}
+
+func (pserv *ProductServer) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
+	cursor := pageCursor{Vendor: req.GetVendor(), ProductType: req.GetProductType()}
+	if req.GetPageToken() != "" {
+		var err error
+		cursor, err = pserv.pageTokens.decode(req.GetPageToken())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if cursor.Vendor != req.GetVendor() || cursor.ProductType != req.GetProductType() {
+			return nil, status.Error(codes.InvalidArgument, "page token was issued for a different vendor or productType")
+		}
+	}
+
+	pageSize := int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Error(codes.InvalidArgument, "pageSize cannot be negative")
+	case pageSize == 0:
+		pageSize = defaultPageSize
+	case pageSize > maxPageSize:
+		pageSize = maxPageSize
+	}
+
+	products, err := pserv.listAllProducts(req.GetVendor(), req.GetProductType())
+	if err != nil {
+		return nil, storeError(err)
+	}
+	sort.Slice(products, func(i, j int) bool {
+		return products[i].ID < products[j].ID
+	})
+	start := sort.Search(len(products), func(i int) bool {
+		return products[i].ID > cursor.After
+	})
+
+	response := &pb.ListProductsResponse{}
+	end := start + pageSize
+	if end < len(products) {
+		cursor.After = products[end-1].ID
+		response.NextPageToken = pserv.pageTokens.encode(cursor)
+	} else {
+		end = len(products)
+	}
+	for _, product := range products[start:end] {
+		response.Products = append(response.Products, product.catalogProto(pserv.links))
+	}
+	return response, nil
+}
+
+// listAllProducts returns the products of the enabled vendors, narrowed down
+// to vendor and productType when they are not empty.
+func (pserv *ProductServer) listAllProducts(vendor, productType string) ([]Product, error) {
+	vendors, err := pserv.store.ListVendors()
+	if err != nil {
+		return nil, err
+	}
+
+	var products []Product
+	for _, v := range vendors {
+		if !v.Enabled || (vendor != "" && v.Name != vendor) {
+			continue
+		}
+		for _, prodType := range v.ProductTypes {
+			if productType != "" && prodType != productType {
+				continue
+			}
+			typeProducts, err := pserv.store.ListProducts(v.Name, prodType)
+			if err != nil {
+				return nil, err
+			}
+			products = append(products, typeProducts...)
+		}
+	}
+	return products, nil
+}
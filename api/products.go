@@ -2,41 +2,69 @@ package api
 
 import (
 	"context"
-	"fmt"
 	"io"
 	"log"
 	"strings"
 	"sync"
 	"time"
+	"unicode"
 
 	pb "github.com/bharat-rajani/grpc-products-demo/gen/proto/products"
-	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-var allProducts map[string]map[string][]string = map[string]map[string][]string{
-	"google": {
-		"compute": []string{"App Engine", "Cloud Run", "App Engine"},
-		"storage": []string{"Cloud Storage", "Filestore"},
-	},
-	"aws": {
-		"compute": []string{"ECS", "EKR", "AWS Fargate"},
-		"storage": []string{"Amazon Aurora", "Amazon RDS", "Amazon Redshift"},
-	},
-	"oracle": {
-		"compute": []string{"VM", "Bare Metal"},
-		"storage": []string{"Oracle ZFS", "Oracle StorageTek"},
-	},
-}
-
-var commonProdChan chan *pb.AdminClientRequestProducts = make(chan *pb.AdminClientRequestProducts, 10)
+// subscriberBuffer is the number of newly set products a GetVendorProducts
+// stream may fall behind by before the broker drops it.
+const subscriberBuffer = 64
 
 type ProductServer struct {
-	ProducTypes map[string][]string
+	store      ProductStore
+	broker     *Broker
+	pageTokens *pageTokens
+	index      *textIndex
+	links      *Shortener
+	chat       *chatHub
+	history    *ChatHistory
+	bot        *chatBot
+	commands   []ChatCommand
+	moderator  *ChatModerator
+	duplicates DuplicatePolicy
+	// catalogMu serialises the catalog mutations made by the server, so the
+	// duplicate checks, exports and restores see a catalog no other request
+	// changes under them
+	catalogMu sync.Mutex
 	pb.UnimplementedProductServiceServer
 }
 
+// ServerOption configures a ProductServer.
+type ServerOption func(*ProductServer)
+
+// WithDuplicatePolicy sets what happens to new products whose title is
+// already listed, DuplicateReject by default.
+func WithDuplicatePolicy(policy DuplicatePolicy) ServerOption {
+	return func(pserv *ProductServer) {
+		pserv.duplicates = policy
+	}
+}
+
+// WithChatHistory sets where the chat rooms keep their history, in memory
+// only by default.
+func WithChatHistory(history *ChatHistory) ServerOption {
+	return func(pserv *ProductServer) {
+		pserv.history = history
+	}
+}
+
+// WithChatModerator sets the rules the chat messages are checked against and
+// where moderation actions are logged, no rules and in memory only by
+// default.
+func WithChatModerator(moderator *ChatModerator) ServerOption {
+	return func(pserv *ProductServer) {
+		pserv.moderator = moderator
+	}
+}
+
 func (pserv *ProductServer) GetVendorProductTypes(ctx context.Context, req *pb.ClientRequestType) (*pb.ClientResponseType, error) {
 
 	log.Printf("have received a request for -> %s <- as vendor", req.GetVendor())
@@ -49,18 +77,32 @@ func (pserv *ProductServer) GetVendorProductTypes(ctx context.Context, req *pb.C
 	}
 
 	log.Println("Prepairing reponse...")
-	if vendorProductTypes, found := pserv.ProducTypes[req.GetVendor()]; found {
+	if err := pserv.checkVendor(req.GetVendor()); err != nil {
+		return nil, err
+	}
+	vendor, err := pserv.store.GetVendor(req.GetVendor())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not list product types: %v", err)
+	}
 
-		for _, prodType := range vendorProductTypes {
-			prodTypes = append(prodTypes, req.GetVendor()+" "+prodType)
-		}
+	var typeInfos []*pb.ProductTypeInfo
+	for _, prodType := range vendor.ProductTypes {
+		prodTypes = append(prodTypes, req.GetVendor()+" "+prodType)
 
-	} else {
-		return nil, status.Error(codes.InvalidArgument, "Wrong vendor, select between google, aws, oracle")
+		products, err := pserv.store.ListProducts(req.GetVendor(), prodType)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not list products: %v", err)
+		}
+		typeInfos = append(typeInfos, &pb.ProductTypeInfo{
+			Name:         prodType,
+			DisplayName:  vendor.DisplayName + " " + titleCase(prodType),
+			ProductCount: int32(len(products)),
+		})
 	}
 
 	clientResponse := pb.ClientResponseType{
-		ProductType: strings.Join(prodTypes, ","),
+		ProductType:  strings.Join(prodTypes, ","),
+		ProductTypes: typeInfos,
 	}
 
 	// if ctx.Err() == context.DeadlineExceeded {
@@ -76,46 +118,81 @@ func (pserv *ProductServer) GetVendorProducts(req *pb.ClientRequestProducts, str
 
 	log.Printf("have received a request for -> %s <- product type from -> %s <- vendor", req.GetProductType(), req.GetVendor())
 
-	// log.Printf("fetch response for id : %d", in.Id)
-	ctx := stream.Context()
+	// unknown vendors may still be followed, waiting for their first products
+	if vendor, err := pserv.store.GetVendor(req.GetVendor()); err == nil && !vendor.Enabled {
+		return pserv.checkVendor(req.GetVendor())
+	}
+
+	if req.GetMode() == pb.StreamMode_STREAM_MODE_SNAPSHOT {
+		return pserv.sendSnapshot(req, stream)
+	}
+
+	// the producer is stopped through ctx whenever the handler returns,
+	// whether the client went away or sending failed
+	ctx, cancel := context.WithCancel(stream.Context())
 
-	productChan := make(chan string, 10)
+	// subscribe before listing the catalog so no product set in between is missed
+	sub := pserv.broker.Subscribe(req.GetVendor(), req.GetProductType())
+	defer sub.Cancel()
+
+	productChan := make(chan Product)
 	var wg sync.WaitGroup
 	wg.Add(1)
-	go readProducts(ctx, wg, productChan, req.GetVendor(), req.GetProductType())
-	for {
+	go readProducts(ctx, &wg, pserv.store, sub, productChan, req.GetVendor(), req.GetProductType())
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
 
-		product := <-productChan
-		if ctx.Err() == context.DeadlineExceeded {
-			log.Printf("dealine has exceeded, stoping server side operation")
-			return status.Error(codes.DeadlineExceeded, "Deadline execeeded, stopping..")
-		}
+	for {
+		select {
+		case <-ctx.Done():
+			return contextError(ctx.Err())
+
+		case product, ok := <-productChan:
+			if !ok {
+				if err := sub.Err(); err != nil {
+					log.Printf("subscription ended: %v", err)
+					return status.Error(codes.ResourceExhausted, "Client too slow to receive products, stopping...")
+				}
+				return contextError(stream.Context().Err())
+			}
 
-		if ctx.Err() == context.Canceled {
-			log.Print("the user has canceled the request, stoping server side operation")
-			return status.Error(codes.Canceled, "User cancelled, stopping...")
+			if err := pserv.sendProduct(stream, product); err != nil {
+				return err
+			}
 		}
+	}
+}
 
-		// time.Sleep(time.Duration(1) * time.Second)
-
-		id := uuid.Must(uuid.NewRandom()).String()
+// sendSnapshot streams the products currently in the catalog and returns,
+// ending the stream with OK.
+func (pserv *ProductServer) sendSnapshot(req *pb.ClientRequestProducts, stream pb.ProductService_GetVendorProductsServer) error {
+	products, err := pserv.store.ListProducts(req.GetVendor(), req.GetProductType())
+	switch err {
+	case nil:
+	case ErrUnknownVendor, ErrUnknownProductType:
+		return status.Errorf(codes.NotFound, "no %s products from %s", req.GetProductType(), req.GetVendor())
+	default:
+		return status.Errorf(codes.Internal, "could not list products: %v", err)
+	}
 
-		if err := stream.Send(&pb.ClientResponseProducts{
-			Product: &pb.ProdsPrep{
-				Title:    product,
-				Url:      "sampleUrl",
-				ShortUrl: "https://made-up-url.com/" + id[:6],
-			},
-		}); err != nil {
+	for _, product := range products {
+		if err := stream.Context().Err(); err != nil {
+			return contextError(err)
+		}
+		if err := pserv.sendProduct(stream, product); err != nil {
 			return err
 		}
-
 	}
-	wg.Wait()
 	log.Printf("the response was sent to client")
 	return nil
 }
 
+func (pserv *ProductServer) sendProduct(stream pb.ProductService_GetVendorProductsServer, product Product) error {
+	return stream.Send(&pb.ClientResponseProducts{Product: product.toProto(pserv.links)})
+}
+
 func (pserv *ProductServer) SetVendorProducts(stream pb.ProductService_SetVendorProductsServer) error {
 
 	// log.Printf("have received a request for -> %s <- product type from -> %s <- vendor", req.GetProductType(), req.GetVendor())
@@ -147,61 +224,319 @@ func (pserv *ProductServer) SetVendorProducts(stream pb.ProductService_SetVendor
 			return status.Error(codes.Canceled, "Client cancelled connection.")
 		}
 
+		if err := pserv.saveProduct(product); err != nil {
+			return err
+		}
 		productCnt++
-		saveProduct(product)
 	}
 }
 
-func (s *ProductServer) ChatVendorSales(stream pb.ProductService_ChatVendorSalesServer) error {
-	ctx := stream.Context()
-	startTime := time.Now()
-	for {
-		msg, err := stream.Recv()
-		fmt.Println("Received: ", msg)
-		if err == io.EOF {
-			endTime := time.Now()
-			log.Printf("Elapsed Time (sec): %d", int32(endTime.Sub(startTime).Seconds()))
-			return stream.Send(&pb.ChatMessage{MessageContent: "goodbye"})
+func (pserv *ProductServer) CreateProduct(ctx context.Context, req *pb.CatalogProduct) (*pb.CatalogProduct, error) {
+	log.Printf("have received a request to create -> %s <- %s product from -> %s <- vendor", req.GetProduct().GetTitle(), req.GetProductType(), req.GetVendor())
+
+	product, err := pserv.validateCatalogProduct(Product{
+		Vendor:      req.GetVendor(),
+		ProductType: req.GetProductType(),
+		Title:       req.GetProduct().GetTitle(),
+		URL:         req.GetProduct().GetUrl(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	product, err = pserv.addProduct(product)
+	if err != nil {
+		return nil, storeError(err)
+	}
+	return product.catalogProto(pserv.links), nil
+}
+
+func (pserv *ProductServer) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.CatalogProduct, error) {
+	product, err := pserv.store.GetProduct(req.GetId())
+	if err != nil {
+		return nil, storeError(err)
+	}
+	return product.catalogProto(pserv.links), nil
+}
+
+func (pserv *ProductServer) UpdateProduct(ctx context.Context, req *pb.CatalogProduct) (*pb.CatalogProduct, error) {
+	log.Printf("have received a request to update product -> %s <-", req.GetProduct().GetId())
+
+	if req.GetProduct().GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "product id is required")
+	}
+
+	pserv.catalogMu.Lock()
+	defer pserv.catalogMu.Unlock()
+	product, err := pserv.store.GetProduct(req.GetProduct().GetId())
+	if err != nil {
+		return nil, storeError(err)
+	}
+	previousURL := product.URL
+	if req.GetVendor() != "" {
+		product.Vendor = req.GetVendor()
+	}
+	if req.GetProductType() != "" {
+		product.ProductType = req.GetProductType()
+	}
+	if req.GetProduct().GetTitle() != "" {
+		product.Title = req.GetProduct().GetTitle()
+	}
+	if req.GetProduct().GetUrl() != "" {
+		product.URL = req.GetProduct().GetUrl()
+	}
+	if product, err = pserv.validateCatalogProduct(product); err != nil {
+		return nil, err
+	}
+
+	if pserv.duplicates != DuplicateKeep {
+		if _, found, err := pserv.findDuplicate(product); err != nil || found {
+			if err == nil {
+				err = ErrDuplicateProduct
+			}
+			return nil, storeError(err)
 		}
+	}
+	if err := pserv.store.UpdateProduct(product); err != nil {
+		return nil, storeError(err)
+	}
+	pserv.shortenProduct(product, previousURL)
+	pserv.index.add(product)
+	return product.catalogProto(pserv.links), nil
+}
+
+func (pserv *ProductServer) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error) {
+	log.Printf("have received a request to delete product -> %s <-", req.GetId())
+
+	pserv.catalogMu.Lock()
+	defer pserv.catalogMu.Unlock()
+	if err := pserv.store.DeleteProduct(req.GetId()); err != nil {
+		return nil, storeError(err)
+	}
+	pserv.index.remove(req.GetId())
+	return &pb.DeleteProductResponse{}, nil
+}
+
+// NewProductServer returns a ProductServer serving the catalog held by store
+// and handing out the short URLs of links.
+func NewProductServer(store ProductStore, links *Shortener, opts ...ServerOption) *ProductServer {
+	pserv := &ProductServer{
+		store:      store,
+		broker:     NewBroker(subscriberBuffer),
+		pageTokens: newPageTokens(),
+		index:      newTextIndex(),
+		links:      links,
+	}
+	for _, opt := range opts {
+		opt(pserv)
+	}
+	if pserv.history == nil {
+		pserv.history = NewChatHistory()
+	}
+	if pserv.moderator == nil {
+		pserv.moderator = NewChatModerator(ChatRules{})
+	}
+	pserv.chat = newChatHub(chatBuffer, pserv.history)
+	pserv.bot = newChatBot()
+	for _, command := range append(pserv.chatCommands(), pserv.commands...) {
+		pserv.bot.register(command)
+	}
+	if err := pserv.indexCatalog(); err != nil {
+		log.Printf("could not index the catalog: %v", err)
+	}
+	return pserv
+}
+
+func (pserv *ProductServer) saveProduct(req *pb.AdminClientRequestProducts) error {
+	log.Printf("Saving prdouct %v\n", req)
+	product, err := pserv.validateProduct(req)
+	if err != nil {
+		return err
+	}
+	if _, err := pserv.addProductOnce(req.GetIdempotencyKey(), product); err != nil {
+		return storeError(err)
+	}
+	return nil
+}
+
+// addProduct stores a new product according to the duplicate policy, indexes
+// it and publishes it to the GetVendorProducts subscribers.
+func (pserv *ProductServer) addProduct(product Product) (Product, error) {
+	return pserv.addProductOnce("", product)
+}
+
+// addProductOnce is addProduct for products sent with an idempotency key. A
+// retried product is only looked up, not indexed or published again.
+func (pserv *ProductServer) addProductOnce(key string, product Product) (Product, error) {
+	pserv.catalogMu.Lock()
+	defer pserv.catalogMu.Unlock()
+
+	// a retry must not be taken for a duplicate of itself
+	if key != "" {
+		stored, found, err := pserv.store.PreviousPut(key, product)
 		if err != nil {
-			return err
+			return Product{}, err
 		}
-
-		if ctx.Err() == context.Canceled {
-			log.Print("the user has canceled the request, stoping server side operation")
-			return status.Error(codes.Canceled, "Client cancelled connection.")
+		if found {
+			log.Printf("product %s was already stored for idempotency key %s", stored.ID, key)
+			return stored, nil
 		}
+	}
 
+	listed, found, err := pserv.findDuplicate(product)
+	if err != nil {
+		return Product{}, err
+	}
+	previousURL := ""
+	switch {
+	case found && pserv.duplicates == DuplicateReject:
+		return Product{}, ErrDuplicateProduct
+	case found && pserv.duplicates == DuplicateUpsert:
+		product.ID = listed.ID
+		previousURL = listed.URL
+		if key != "" {
+			err = pserv.store.UpdateProductOnce(key, product)
+		} else {
+			err = pserv.store.UpdateProduct(product)
+		}
+	case key != "":
+		product, _, err = pserv.store.PutProductOnce(key, product)
+	default:
+		product, err = pserv.store.PutProduct(product)
+	}
+	if err != nil {
+		return Product{}, err
 	}
+	pserv.shortenProduct(product, previousURL)
+	pserv.index.add(product)
+	pserv.broker.Publish(product)
+	return product, nil
 }
 
-func NewProductServer(productTypes map[string][]string) *ProductServer {
-	return &ProductServer{ProducTypes: productTypes}
+// shortenProduct assigns the URL of a stored product its short code. When the
+// URL of the product changed from previousURL and no other product lists
+// previousURL, the code moves along with the product. A failure only costs the
+// product its short URL, so it is logged. It must be called with
+// pserv.catalogMu held.
+func (pserv *ProductServer) shortenProduct(product Product, previousURL string) {
+	if product.URL == "" {
+		return
+	}
+	var err error
+	if previousURL != "" && previousURL != product.URL && !pserv.listsURL(previousURL) {
+		_, err = pserv.links.Move(previousURL, product.URL)
+	} else {
+		_, err = pserv.links.Shorten(product.URL)
+	}
+	if err != nil && err != ErrNotShortenable {
+		log.Printf("could not shorten %s: %v", product.URL, err)
+	}
 }
 
-func saveProduct(product *pb.AdminClientRequestProducts) {
-	log.Printf("Saving prdouct %v\n", product)
-	commonProdChan <- product
+// listsURL reports whether a product of the catalog has rawURL. It must be
+// called with pserv.catalogMu held.
+func (pserv *ProductServer) listsURL(rawURL string) bool {
+	catalog, err := pserv.currentCatalog()
+	if err != nil {
+		// keep the code with the URL rather than move it from a listed product
+		return true
+	}
+	for _, product := range catalog.Products {
+		if product.URL == rawURL {
+			return true
+		}
+	}
+	return false
 }
 
-func readProducts(ctx context.Context, wg sync.WaitGroup, productChan chan<- string, vendor string, productType string) {
-
-	for _, product := range allProducts[vendor][productType] {
-		productChan <- product
+// readProducts feeds productChan with the current catalog followed by the
+// products delivered to sub. Products set between subscribing and listing
+// arrive both ways and are only fed once. It closes productChan and returns
+// as soon as ctx is done or the subscription ends.
+func readProducts(ctx context.Context, wg *sync.WaitGroup, store ProductStore, sub *Subscription, productChan chan<- Product, vendor string, productType string) {
+	defer wg.Done()
+	defer close(productChan)
+
+	products, err := store.ListProducts(vendor, productType)
+	if err != nil {
+		log.Printf("could not list %s products from %s: %v", productType, vendor, err)
+	}
+	listed := make(map[string]Product, len(products))
+	for _, product := range products {
+		listed[product.ID] = product
+		select {
+		case productChan <- product:
+		case <-ctx.Done():
+			log.Println("Request done/cancelled.")
+			return
+		}
 	}
 
 	for {
 		select {
-		case c := <-commonProdChan:
-			if c.Vendor == vendor && c.ProductType == productType {
-				productChan <- c.Product.Title
+		case product, ok := <-sub.C:
+			if !ok {
+				return
+			}
+			// only the first delivery of a listed product can repeat it
+			if listedProduct, found := listed[product.ID]; found {
+				delete(listed, product.ID)
+				if listedProduct == product {
+					continue
+				}
+			}
+			select {
+			case productChan <- product:
+			case <-ctx.Done():
+				log.Println("Request done/cancelled.")
+				return
 			}
 
-			// case <-ctx.Done():
-			// 	log.Println("Request done/cancelled.")
-			// 	wg.Done()
+		case <-ctx.Done():
+			log.Println("Request done/cancelled.")
+			return
 		}
 	}
-	wg.Done()
+}
 
+// titleCase upper-cases the first letter of every word of s.
+func titleCase(s string) string {
+	words := strings.Fields(s)
+	for i, word := range words {
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		words[i] = string(runes)
+	}
+	return strings.Join(words, " ")
+}
+
+// storeError converts an error returned by the ProductStore to the status
+// returned to the client.
+func storeError(err error) error {
+	switch err {
+	case ErrProductNotFound, ErrUnknownVendor, ErrUnknownProductType:
+		return status.Error(codes.NotFound, err.Error())
+	case ErrVendorExists, ErrDuplicateProduct:
+		return status.Error(codes.AlreadyExists, err.Error())
+	case ErrProductTypeNotEmpty:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case ErrIdempotencyKeyReused:
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "catalog error: %v", err)
+	}
+}
+
+// contextError converts the error of a finished request context to the
+// status returned to the client.
+func contextError(err error) error {
+	switch err {
+	case context.DeadlineExceeded:
+		log.Printf("dealine has exceeded, stoping server side operation")
+		return status.Error(codes.DeadlineExceeded, "Deadline execeeded, stopping..")
+	case context.Canceled:
+		log.Print("the user has canceled the request, stoping server side operation")
+		return status.Error(codes.Canceled, "User cancelled, stopping...")
+	default:
+		return status.FromContextError(err).Err()
+	}
 }
@@ -0,0 +1,119 @@
+package api
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	pb "github.com/bharat-rajani/grpc-products-demo/gen/proto/products"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeProductsStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pb.ClientResponseProducts
+}
+
+func (f *fakeProductsStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeProductsStream) Send(resp *pb.ClientResponseProducts) error {
+	select {
+	case f.sent <- resp:
+		return nil
+	case <-f.ctx.Done():
+		return f.ctx.Err()
+	}
+}
+
+func TestGetVendorProductsStopsOnClientGone(t *testing.T) {
+	tests := []struct {
+		name string
+		code codes.Code
+		ctx  func() (context.Context, context.CancelFunc)
+	}{
+		{
+			name: "cancel",
+			code: codes.Canceled,
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithCancel(context.Background())
+			},
+		},
+		{
+			name: "deadline",
+			code: codes.DeadlineExceeded,
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 200*time.Millisecond)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			goroutines := runtime.NumGoroutine()
+
+			pserv := NewProductServer(NewMemoryStore(Catalog{
+				Products: []Product{
+					{Vendor: "aws", ProductType: "storage", Title: "Amazon RDS", URL: "https://aws.amazon.com/rds"},
+				},
+			}), NewShortener("http://localhost:8081"))
+			ctx, cancel := tt.ctx()
+			defer cancel()
+			stream := &fakeProductsStream{ctx: ctx, sent: make(chan *pb.ClientResponseProducts)}
+
+			done := make(chan error, 1)
+			go func() {
+				done <- pserv.GetVendorProducts(&pb.ClientRequestProducts{
+					Vendor:      "aws",
+					ProductType: "storage",
+					Mode:        pb.StreamMode_STREAM_MODE_FOLLOW,
+				}, stream)
+			}()
+
+			if got := (<-stream.sent).GetProduct().GetTitle(); got != "Amazon RDS" {
+				t.Fatalf("first product = %q, want %q", got, "Amazon RDS")
+			}
+			err := pserv.saveProduct(&pb.AdminClientRequestProducts{
+				Vendor:      "aws",
+				ProductType: "storage",
+				Product:     &pb.ProdsPrep{Title: "Amazon S3", Url: "https://aws.amazon.com/s3"},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := (<-stream.sent).GetProduct().GetTitle(); got != "Amazon S3" {
+				t.Fatalf("live product = %q, want %q", got, "Amazon S3")
+			}
+
+			// the client stops reading and goes away
+			if tt.code == codes.Canceled {
+				cancel()
+			}
+			select {
+			case err := <-done:
+				if status.Code(err) != tt.code {
+					t.Fatalf("GetVendorProducts() = %v, want code %v", err, tt.code)
+				}
+			case <-time.After(time.Second):
+				t.Fatal("GetVendorProducts() did not return after the client went away")
+			}
+
+			if n := pserv.broker.Subscribers(); n != 0 {
+				t.Errorf("broker still has %d subscribers", n)
+			}
+			deadline := time.Now().Add(time.Second)
+			for runtime.NumGoroutine() > goroutines {
+				if time.Now().After(deadline) {
+					buf := make([]byte, 1<<16)
+					t.Fatalf("leaked %d goroutines:\n%s", runtime.NumGoroutine()-goroutines, buf[:runtime.Stack(buf, true)])
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package api
+
+import (
+	"io"
+	"log"
+	"reflect"
+
+	pb "github.com/bharat-rajani/grpc-products-demo/gen/proto/products"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RestoreCatalog reads back an ExportCatalog stream, checks it is complete
+// and unaltered, and applies it to the catalog in a single store operation.
+// Replacing the catalog with an export restricted to one vendor only replaces
+// that vendor. Restored products are not checked against the duplicate
+// policy, so that a backup comes back as it was taken.
+func (pserv *ProductServer) RestoreCatalog(stream pb.ProductService_RestoreCatalogServer) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "empty restore stream")
+	}
+	if err != nil {
+		return err
+	}
+	mode, dryRun := first.GetMode(), first.GetDryRun()
+	log.Printf("have received a request to restore the catalog, mode: %v, dry run: %v", mode, dryRun)
+
+	exported, scope, err := readExport(first, stream)
+	if err != nil {
+		return err
+	}
+
+	pserv.catalogMu.Lock()
+	defer pserv.catalogMu.Unlock()
+
+	current, err := pserv.currentCatalog()
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not read the catalog: %v", err)
+	}
+	restored := exported
+	switch {
+	case mode == pb.RestoreMode_RESTORE_MODE_MERGE:
+		restored = mergeCatalogs(current, exported)
+	case scope != "":
+		restored = replaceVendor(current, exported, scope)
+	}
+	response, added, changed, removed := diffCatalogs(current, restored)
+	if dryRun {
+		return stream.SendAndClose(response)
+	}
+
+	if err := pserv.store.ReplaceCatalog(restored); err != nil {
+		return storeError(err)
+	}
+	for _, product := range removed {
+		pserv.index.remove(product.ID)
+	}
+	previousURLs := make(map[string]string, len(current.Products))
+	for _, product := range current.Products {
+		previousURLs[product.ID] = product.URL
+	}
+	for _, product := range append(added, changed...) {
+		pserv.shortenProduct(product, previousURLs[product.ID])
+		pserv.index.add(product)
+		pserv.broker.Publish(product)
+	}
+	response.Applied = true
+	log.Printf("have restored %d vendors and %d products", len(restored.Vendors), len(restored.Products))
+	return stream.SendAndClose(response)
+}
+
+// readExport reads the export records of a RestoreCatalog stream starting
+// with first, verifying its layout version, counts and checksum. It returns
+// the vendor the export is restricted to alongside, if any.
+func readExport(first *pb.RestoreCatalogRequest, stream pb.ProductService_RestoreCatalogServer) (Catalog, string, error) {
+	header := first.GetRecord().GetHeader()
+	if header == nil {
+		return Catalog{}, "", status.Error(codes.InvalidArgument, "restore stream must start with the export header")
+	}
+	if header.GetFormatVersion() != exportFormatVersion {
+		return Catalog{}, "", status.Errorf(codes.InvalidArgument, "unsupported export format version %d", header.GetFormatVersion())
+	}
+
+	scope := header.GetVendor()
+	var catalog Catalog
+	hasher := newCatalogHasher(scope)
+	ids := make(map[string]bool)
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return Catalog{}, "", status.Error(codes.InvalidArgument, "restore stream ended before the export trailer")
+		}
+		if err != nil {
+			return Catalog{}, "", err
+		}
+		if err := stream.Context().Err(); err != nil {
+			return Catalog{}, "", contextError(err)
+		}
+
+		switch r := req.GetRecord().GetRecord().(type) {
+		case *pb.ExportRecord_Vendor:
+			if len(catalog.Products) > 0 {
+				return Catalog{}, "", status.Errorf(codes.InvalidArgument, "vendor %s comes after the products", r.Vendor.GetName())
+			}
+			if scope != "" && r.Vendor.GetName() != scope {
+				return Catalog{}, "", status.Errorf(codes.InvalidArgument, "vendor %s is outside the export of %s", r.Vendor.GetName(), scope)
+			}
+			hasher.addVendor(r.Vendor)
+			catalog.Vendors = append(catalog.Vendors, Vendor{
+				Name:         r.Vendor.GetName(),
+				DisplayName:  r.Vendor.GetDisplayName(),
+				Homepage:     r.Vendor.GetHomepage(),
+				Enabled:      r.Vendor.GetEnabled(),
+				ProductTypes: r.Vendor.GetProductTypes(),
+			})
+		case *pb.ExportRecord_Product:
+			id := r.Product.GetProduct().GetId()
+			if id == "" || ids[id] {
+				return Catalog{}, "", status.Errorf(codes.InvalidArgument, "product %q has a missing or repeated id", r.Product.GetProduct().GetTitle())
+			}
+			if scope != "" && r.Product.GetVendor() != scope {
+				return Catalog{}, "", status.Errorf(codes.InvalidArgument, "product %s is outside the export of %s", id, scope)
+			}
+			ids[id] = true
+			hasher.addProduct(r.Product)
+			catalog.Products = append(catalog.Products, Product{
+				ID:          id,
+				Vendor:      r.Product.GetVendor(),
+				ProductType: r.Product.GetProductType(),
+				Title:       r.Product.GetProduct().GetTitle(),
+				URL:         r.Product.GetProduct().GetUrl(),
+			})
+		case *pb.ExportRecord_Trailer:
+			trailer := r.Trailer
+			if int(trailer.GetVendorCount()) != len(catalog.Vendors) || int(trailer.GetProductCount()) != len(catalog.Products) {
+				return Catalog{}, "", status.Errorf(codes.DataLoss, "export lists %d vendors and %d products, %d and %d were received",
+					trailer.GetVendorCount(), trailer.GetProductCount(), len(catalog.Vendors), len(catalog.Products))
+			}
+			if trailer.GetChecksum() != hasher.sum() {
+				return Catalog{}, "", status.Error(codes.DataLoss, "export checksum mismatch, it was altered or truncated")
+			}
+			return catalog, scope, nil
+		default:
+			return Catalog{}, "", status.Error(codes.InvalidArgument, "unexpected record in the restore stream")
+		}
+	}
+}
+
+// currentCatalog copies the catalog. It must be called with pserv.catalogMu
+// held.
+func (pserv *ProductServer) currentCatalog() (Catalog, error) {
+	vendors, err := pserv.store.ListVendors()
+	if err != nil {
+		return Catalog{}, err
+	}
+	catalog := Catalog{Vendors: vendors}
+	for _, vendor := range vendors {
+		for _, prodType := range vendor.ProductTypes {
+			products, err := pserv.store.ListProducts(vendor.Name, prodType)
+			if err != nil {
+				return Catalog{}, err
+			}
+			catalog.Products = append(catalog.Products, products...)
+		}
+	}
+	return catalog, nil
+}
+
+// mergeCatalogs returns current with the vendors and products of exported
+// replacing the ones with the same name or id, and the others appended.
+// Merged vendors keep their current product types besides the exported ones.
+func mergeCatalogs(current, exported Catalog) Catalog {
+	var merged Catalog
+	expVendors := make(map[string]Vendor)
+	for _, vendor := range exported.Vendors {
+		expVendors[vendor.Name] = vendor
+	}
+	for _, vendor := range current.Vendors {
+		if expVendor, found := expVendors[vendor.Name]; found {
+			delete(expVendors, vendor.Name)
+			types := expVendor.ProductTypes
+			for _, prodType := range vendor.ProductTypes {
+				if !hasProductType(expVendor, prodType) {
+					types = append(types, prodType)
+				}
+			}
+			vendor = expVendor
+			vendor.ProductTypes = types
+		}
+		merged.Vendors = append(merged.Vendors, vendor.clone())
+	}
+	for _, vendor := range exported.Vendors {
+		if _, found := expVendors[vendor.Name]; found {
+			merged.Vendors = append(merged.Vendors, vendor.clone())
+		}
+	}
+
+	expProducts := make(map[string]Product)
+	for _, product := range exported.Products {
+		expProducts[product.ID] = product
+	}
+	for _, product := range current.Products {
+		if expProduct, found := expProducts[product.ID]; found {
+			delete(expProducts, product.ID)
+			product = expProduct
+		}
+		merged.Products = append(merged.Products, product)
+	}
+	for _, product := range exported.Products {
+		if _, found := expProducts[product.ID]; found {
+			merged.Products = append(merged.Products, product)
+		}
+	}
+	return merged
+}
+
+// replaceVendor returns current with vendor and its products replaced by the
+// ones of exported, an export restricted to vendor. The other vendors and
+// their products are kept.
+func replaceVendor(current, exported Catalog, vendor string) Catalog {
+	var replaced Catalog
+	found := false
+	for _, v := range current.Vendors {
+		if v.Name == vendor {
+			replaced.Vendors = append(replaced.Vendors, exported.Vendors...)
+			found = true
+			continue
+		}
+		replaced.Vendors = append(replaced.Vendors, v.clone())
+	}
+	if !found {
+		replaced.Vendors = append(replaced.Vendors, exported.Vendors...)
+	}
+
+	// a product moved to another vendor since the export is restored too
+	expIDs := make(map[string]bool, len(exported.Products))
+	for _, product := range exported.Products {
+		expIDs[product.ID] = true
+	}
+	for _, product := range current.Products {
+		if product.Vendor != vendor && !expIDs[product.ID] {
+			replaced.Products = append(replaced.Products, product)
+		}
+	}
+	replaced.Products = append(replaced.Products, exported.Products...)
+	return replaced
+}
+
+// diffCatalogs reports how restoring restored over current changes the
+// catalog, returning the added, changed and removed products alongside.
+func diffCatalogs(current, restored Catalog) (response *pb.RestoreCatalogResponse, added, changed, removed []Product) {
+	response = &pb.RestoreCatalogResponse{}
+	currentVendors := make(map[string]Vendor)
+	for _, vendor := range current.Vendors {
+		currentVendors[vendor.Name] = vendor
+	}
+	for _, vendor := range restored.Vendors {
+		previous, found := currentVendors[vendor.Name]
+		switch {
+		case !found:
+			response.VendorsAdded = append(response.VendorsAdded, vendor.Name)
+		case !reflect.DeepEqual(previous.clone(), vendor.clone()):
+			response.VendorsChanged = append(response.VendorsChanged, vendor.Name)
+		}
+		delete(currentVendors, vendor.Name)
+	}
+	for _, vendor := range current.Vendors {
+		if _, found := currentVendors[vendor.Name]; found {
+			response.VendorsRemoved = append(response.VendorsRemoved, vendor.Name)
+		}
+	}
+
+	currentProducts := make(map[string]Product)
+	for _, product := range current.Products {
+		currentProducts[product.ID] = product
+	}
+	for _, product := range restored.Products {
+		previous, found := currentProducts[product.ID]
+		switch {
+		case !found:
+			added = append(added, product)
+		case previous != product:
+			changed = append(changed, product)
+		}
+		delete(currentProducts, product.ID)
+	}
+	for _, product := range current.Products {
+		if _, found := currentProducts[product.ID]; found {
+			removed = append(removed, product)
+		}
+	}
+	response.ProductsAdded = int32(len(added))
+	response.ProductsChanged = int32(len(changed))
+	response.ProductsRemoved = int32(len(removed))
+	return response, added, changed, removed
+}
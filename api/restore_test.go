@@ -0,0 +1,235 @@
+package api
+
+import (
+	"context"
+	"io"
+	"reflect"
+	"sort"
+	"testing"
+
+	pb "github.com/bharat-rajani/grpc-products-demo/gen/proto/products"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeExportStream struct {
+	grpc.ServerStream
+	records []*pb.ExportRecord
+}
+
+func (f *fakeExportStream) Context() context.Context {
+	return context.Background()
+}
+
+func (f *fakeExportStream) Send(record *pb.ExportRecord) error {
+	f.records = append(f.records, record)
+	return nil
+}
+
+type fakeRestoreStream struct {
+	grpc.ServerStream
+	received []*pb.RestoreCatalogRequest
+	response *pb.RestoreCatalogResponse
+}
+
+func (f *fakeRestoreStream) Context() context.Context {
+	return context.Background()
+}
+
+func (f *fakeRestoreStream) Recv() (*pb.RestoreCatalogRequest, error) {
+	if len(f.received) == 0 {
+		return nil, io.EOF
+	}
+	req := f.received[0]
+	f.received = f.received[1:]
+	return req, nil
+}
+
+func (f *fakeRestoreStream) SendAndClose(response *pb.RestoreCatalogResponse) error {
+	f.response = response
+	return nil
+}
+
+var restoreTestCatalog = Catalog{
+	Vendors: []Vendor{
+		{Name: "aws", DisplayName: "Amazon Web Services", Enabled: true, ProductTypes: []string{"storage"}},
+		{Name: "google", DisplayName: "Google Cloud", Enabled: true, ProductTypes: []string{"compute"}},
+	},
+	Products: []Product{
+		{Vendor: "aws", ProductType: "storage", Title: "Amazon RDS", URL: "https://aws.amazon.com/rds"},
+		{Vendor: "aws", ProductType: "storage", Title: "Amazon S3", URL: "https://aws.amazon.com/s3"},
+		{Vendor: "google", ProductType: "compute", Title: "Cloud Run", URL: "https://cloud.google.com/run"},
+	},
+}
+
+// exportTestCatalog returns a server serving restoreTestCatalog and its
+// export, restricted to vendor if set.
+func exportTestCatalog(t *testing.T, vendor string) (*ProductServer, []*pb.ExportRecord) {
+	t.Helper()
+	pserv := NewProductServer(NewMemoryStore(restoreTestCatalog), NewShortener("http://localhost:8081"))
+	stream := &fakeExportStream{}
+	if err := pserv.ExportCatalog(&pb.ExportCatalogRequest{Vendor: vendor}, stream); err != nil {
+		t.Fatalf("ExportCatalog() = %v", err)
+	}
+	return pserv, stream.records
+}
+
+func restore(pserv *ProductServer, mode pb.RestoreMode, records []*pb.ExportRecord) error {
+	stream := &fakeRestoreStream{}
+	for _, record := range records {
+		stream.received = append(stream.received, &pb.RestoreCatalogRequest{Mode: mode, Record: record})
+	}
+	return pserv.RestoreCatalog(stream)
+}
+
+// listed returns the vendor/title of the products of pserv, sorted.
+func listed(t *testing.T, pserv *ProductServer) []string {
+	t.Helper()
+	catalog, err := pserv.currentCatalog()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var products []string
+	for _, product := range catalog.Products {
+		products = append(products, product.Vendor+"/"+product.Title)
+	}
+	sort.Strings(products)
+	return products
+}
+
+func TestRestoreCatalogRejectsDamagedExports(t *testing.T) {
+	tests := []struct {
+		name   string
+		code   codes.Code
+		damage func(records []*pb.ExportRecord) []*pb.ExportRecord
+	}{
+		{
+			name: "tampered",
+			code: codes.DataLoss,
+			damage: func(records []*pb.ExportRecord) []*pb.ExportRecord {
+				product := records[len(records)-2].GetProduct()
+				product.Product.Url = "https://example.com"
+				return records
+			},
+		},
+		{
+			name: "missing product",
+			code: codes.DataLoss,
+			damage: func(records []*pb.ExportRecord) []*pb.ExportRecord {
+				return append(records[:len(records)-2:len(records)-2], records[len(records)-1])
+			},
+		},
+		{
+			name: "truncated",
+			code: codes.InvalidArgument,
+			damage: func(records []*pb.ExportRecord) []*pb.ExportRecord {
+				return records[:len(records)-1]
+			},
+		},
+		{
+			name: "scope removed",
+			code: codes.DataLoss,
+			damage: func(records []*pb.ExportRecord) []*pb.ExportRecord {
+				records[0].GetHeader().Vendor = ""
+				return records
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pserv, records := exportTestCatalog(t, "aws")
+			want := listed(t, pserv)
+			if _, err := pserv.store.PutProduct(Product{Vendor: "google", ProductType: "compute", Title: "App Engine"}); err != nil {
+				t.Fatal(err)
+			}
+			want = append(want, "google/App Engine")
+			sort.Strings(want)
+
+			err := restore(pserv, pb.RestoreMode_RESTORE_MODE_REPLACE, tt.damage(records))
+			if status.Code(err) != tt.code {
+				t.Fatalf("RestoreCatalog() = %v, want code %v", err, tt.code)
+			}
+			if got := listed(t, pserv); !reflect.DeepEqual(got, want) {
+				t.Fatalf("catalog after a rejected restore = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestRestoreCatalogModes(t *testing.T) {
+	tests := []struct {
+		name   string
+		mode   pb.RestoreMode
+		vendor string
+		want   []string
+	}{
+		{
+			name: "merge",
+			mode: pb.RestoreMode_RESTORE_MODE_MERGE,
+			want: []string{"aws/Amazon Aurora", "aws/Amazon RDS", "aws/Amazon S3", "google/Cloud Run", "oracle/VM"},
+		},
+		{
+			name: "replace",
+			mode: pb.RestoreMode_RESTORE_MODE_REPLACE,
+			want: []string{"aws/Amazon RDS", "aws/Amazon S3", "google/Cloud Run"},
+		},
+		{
+			name:   "replace vendor",
+			mode:   pb.RestoreMode_RESTORE_MODE_REPLACE,
+			vendor: "aws",
+			want:   []string{"aws/Amazon RDS", "aws/Amazon S3", "oracle/VM"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pserv, records := exportTestCatalog(t, tt.vendor)
+			// the catalog changes after the export
+			catalog, err := pserv.currentCatalog()
+			if err != nil {
+				t.Fatal(err)
+			}
+			s3 := catalog.Products[1]
+			s3.Title = "Simple Storage Service"
+			if err := pserv.store.UpdateProduct(s3); err != nil {
+				t.Fatal(err)
+			}
+			if err := pserv.store.DeleteProduct(catalog.Products[2].ID); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := pserv.store.PutProduct(Product{Vendor: "aws", ProductType: "storage", Title: "Amazon Aurora"}); err != nil {
+				t.Fatal(err)
+			}
+			if err := pserv.store.CreateVendor(Vendor{Name: "oracle", Enabled: true, ProductTypes: []string{"compute"}}); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := pserv.store.PutProduct(Product{Vendor: "oracle", ProductType: "compute", Title: "VM"}); err != nil {
+				t.Fatal(err)
+			}
+
+			unchanged := listed(t, pserv)
+			dryRun := &fakeRestoreStream{}
+			for i, record := range records {
+				dryRun.received = append(dryRun.received, &pb.RestoreCatalogRequest{Mode: tt.mode, DryRun: i == 0, Record: record})
+			}
+			if err := pserv.RestoreCatalog(dryRun); err != nil {
+				t.Fatalf("RestoreCatalog() dry run = %v", err)
+			}
+			if got := listed(t, pserv); !reflect.DeepEqual(got, unchanged) {
+				t.Fatalf("catalog after a dry run = %v, want %v", got, unchanged)
+			}
+
+			if err := restore(pserv, tt.mode, records); err != nil {
+				t.Fatalf("RestoreCatalog() = %v", err)
+			}
+			if got := listed(t, pserv); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("restored catalog = %v, want %v", got, tt.want)
+			}
+			if applied := dryRun.response.GetApplied(); applied {
+				t.Fatal("dry run reported the restore as applied")
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	pb "github.com/bharat-rajani/grpc-products-demo/gen/proto/products"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (pserv *ProductServer) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
+	log.Printf("have received a search for -> %s <- ordered by -> %s <-", req.GetFilter(), req.GetOrderBy())
+
+	filter, err := parseFilter(req.GetFilter())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+	less, err := parseOrderBy(req.GetOrderBy())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid orderBy: %v", err)
+	}
+	if req.GetLimit() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit cannot be negative")
+	}
+
+	products, err := pserv.listAllProducts("", "")
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	var matches []Product
+	for _, product := range products {
+		if filter(product) {
+			matches = append(matches, product)
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return less(matches[i], matches[j])
+	})
+
+	response := &pb.SearchProductsResponse{TotalCount: int32(len(matches))}
+	if limit := int(req.GetLimit()); limit > 0 && limit < len(matches) {
+		matches = matches[:limit]
+	}
+	for _, product := range matches {
+		response.Products = append(response.Products, product.catalogProto(pserv.links))
+	}
+	return response, nil
+}
+
+const defaultSearchHits = 20
+
+func (pserv *ProductServer) FullTextSearch(ctx context.Context, req *pb.FullTextSearchRequest) (*pb.FullTextSearchResponse, error) {
+	log.Printf("have received a full-text search for -> %s <-", req.GetQuery())
+
+	if req.GetLimit() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit cannot be negative")
+	}
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = defaultSearchHits
+	}
+
+	enabled := make(map[string]bool)
+	vendors, err := pserv.store.ListVendors()
+	if err != nil {
+		return nil, storeError(err)
+	}
+	for _, vendor := range vendors {
+		enabled[vendor.Name] = vendor.Enabled
+	}
+
+	response := &pb.FullTextSearchResponse{}
+	for _, hit := range pserv.index.search(req.GetQuery()) {
+		if !enabled[hit.product.Vendor] || (req.GetVendor() != "" && hit.product.Vendor != req.GetVendor()) {
+			continue
+		}
+		response.Hits = append(response.Hits, &pb.ProductHit{
+			Product:      hit.product.catalogProto(pserv.links),
+			Score:        hit.score,
+			MatchedTerms: hit.terms,
+		})
+		if len(response.Hits) == limit {
+			break
+		}
+	}
+	return response, nil
+}
+
+// indexCatalog adds every product of the store to the full-text index and
+// assigns the short codes the products are missing.
+func (pserv *ProductServer) indexCatalog() error {
+	vendors, err := pserv.store.ListVendors()
+	if err != nil {
+		return err
+	}
+	for _, vendor := range vendors {
+		for _, prodType := range vendor.ProductTypes {
+			products, err := pserv.store.ListProducts(vendor.Name, prodType)
+			if err != nil {
+				return err
+			}
+			for _, product := range products {
+				pserv.shortenProduct(product, "")
+				pserv.index.add(product)
+			}
+		}
+	}
+	return nil
+}
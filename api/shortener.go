@@ -0,0 +1,262 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	shortLinksFile = "shortlinks.wal"
+	clicksFile     = "clicks.wal"
+
+	shortCodeLength = 7
+	base62Alphabet  = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+// ErrNotShortenable is returned for URLs that are not absolute http(s) URLs.
+var ErrNotShortenable = errors.New("only absolute http and https URLs can be shortened")
+
+type shortLink struct {
+	Code string `json:"code"`
+	URL  string `json:"url"`
+}
+
+// Shortener assigns every product URL a stable short code and serves HTTP
+// redirects from the short URLs to the product URLs, counting the clicks.
+//
+// Codes are derived from a hash of the URL, so the same URL always gets the
+// same code; on the rare collision with another URL the hash is salted until
+// a free code is found. Codes are assigned as products are stored, looking
+// them up never writes. When the URL of a product changes, its code moves to
+// the new URL so its short URL keeps leading to it.
+type Shortener struct {
+	mu      sync.RWMutex
+	baseURL string
+	byCode  map[string]string
+	byURL   map[string]string
+	// log persists the assigned codes, nil when kept in memory only
+	log    *wal
+	clicks *clickCounter
+}
+
+// NewShortener returns a Shortener building short URLs on baseURL and
+// keeping its codes in memory.
+func NewShortener(baseURL string) *Shortener {
+	return &Shortener{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		byCode:  make(map[string]string),
+		byURL:   make(map[string]string),
+		clicks:  newClickCounter(),
+	}
+}
+
+// OpenShortener returns a Shortener building short URLs on baseURL and
+// persisting its codes and clicks in dir.
+func OpenShortener(baseURL, dir string) (*Shortener, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	s := NewShortener(baseURL)
+	path := filepath.Join(dir, shortLinksFile)
+	var err error
+	s.log, err = openWAL(path)
+	if err != nil {
+		return nil, err
+	}
+	err = s.log.replay(func(line json.RawMessage) error {
+		var link shortLink
+		if err := json.Unmarshal(line, &link); err != nil {
+			return fmt.Errorf("decoding %s: %v", path, err)
+		}
+		// a code logged again was moved to another URL
+		if previous, found := s.byCode[link.Code]; found {
+			delete(s.byURL, previous)
+		}
+		s.byCode[link.Code] = link.URL
+		s.byURL[link.URL] = link.Code
+		return nil
+	})
+	if err != nil {
+		s.log.Close()
+		return nil, err
+	}
+	s.clicks, err = openClickCounter(filepath.Join(dir, clicksFile))
+	if err != nil {
+		s.log.Close()
+		return nil, err
+	}
+	log.Printf("loaded %d short links from %s", len(s.byCode), path)
+	return s, nil
+}
+
+// Shorten returns the code of rawURL, assigning one if it has none yet.
+func (s *Shortener) Shorten(rawURL string) (string, error) {
+	s.mu.RLock()
+	code, found := s.byURL[rawURL]
+	s.mu.RUnlock()
+	if found {
+		return code, nil
+	}
+
+	if !shortenable(rawURL) {
+		return "", ErrNotShortenable
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if code, found := s.byURL[rawURL]; found {
+		return code, nil
+	}
+	return s.assign(rawURL)
+}
+
+// Move gives newURL the code of oldURL, for a product whose URL changed and
+// whose previous URL no other product lists, so that its short URL keeps
+// leading to it. newURL keeps its code if it already has one, and gets a new
+// one if oldURL has none.
+func (s *Shortener) Move(oldURL, newURL string) (string, error) {
+	if !shortenable(newURL) {
+		return "", ErrNotShortenable
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if code, found := s.byURL[newURL]; found {
+		return code, nil
+	}
+	code, found := s.byURL[oldURL]
+	if !found {
+		return s.assign(newURL)
+	}
+	if err := s.link(code, newURL); err != nil {
+		return "", err
+	}
+	delete(s.byURL, oldURL)
+	return code, nil
+}
+
+// assign must be called with s.mu held.
+func (s *Shortener) assign(rawURL string) (string, error) {
+	var code string
+	for salt := uint64(0); ; salt++ {
+		code = shortCode(rawURL, salt)
+		if _, taken := s.byCode[code]; !taken {
+			break
+		}
+	}
+	if err := s.link(code, rawURL); err != nil {
+		return "", err
+	}
+	return code, nil
+}
+
+// link must be called with s.mu held. It logs and maps code to rawURL.
+func (s *Shortener) link(code, rawURL string) error {
+	if s.log != nil {
+		if err := s.log.append(shortLink{Code: code, URL: rawURL}); err != nil {
+			return err
+		}
+	}
+	s.byCode[code] = rawURL
+	s.byURL[rawURL] = code
+	return nil
+}
+
+// ShortURL returns the short URL assigned to rawURL, or an empty string if it
+// has none. It only looks the code up, codes are assigned by Shorten.
+func (s *Shortener) ShortURL(rawURL string) string {
+	code, found := s.code(rawURL)
+	if !found {
+		return ""
+	}
+	return s.baseURL + "/" + code
+}
+
+// shortenable reports whether rawURL is an absolute http(s) URL.
+func shortenable(rawURL string) bool {
+	target, err := url.Parse(rawURL)
+	return err == nil && target.IsAbs() && (target.Scheme == "http" || target.Scheme == "https") && target.Host != ""
+}
+
+// code returns the code assigned to rawURL, if any.
+func (s *Shortener) code(rawURL string) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	code, found := s.byURL[rawURL]
+	return code, found
+}
+
+// Resolve returns the URL a code redirects to.
+func (s *Shortener) Resolve(code string) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	target, found := s.byCode[code]
+	return target, found
+}
+
+// ServeHTTP redirects /<code> to the URL of code with a 301 and records the
+// click.
+func (s *Shortener) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	code := strings.TrimPrefix(r.URL.Path, "/")
+	target, found := s.Resolve(code)
+	if !found {
+		http.NotFound(w, r)
+		return
+	}
+	hit := click{
+		Time:      time.Now().UTC(),
+		Code:      code,
+		UserAgent: r.UserAgent(),
+		Referrer:  r.Referer(),
+	}
+	s.clicks.record(hit)
+	http.Redirect(w, r, target, http.StatusMovedPermanently)
+}
+
+// Close releases the files persisting the codes and clicks.
+func (s *Shortener) Close() error {
+	if err := s.clicks.Close(); err != nil {
+		return err
+	}
+	if s.log == nil {
+		return nil
+	}
+	return s.log.Close()
+}
+
+// shortCode derives a base62 code from the hash of rawURL and salt.
+func shortCode(rawURL string, salt uint64) string {
+	h := sha256.New()
+	h.Write([]byte(rawURL))
+	if salt > 0 {
+		var buf [8]byte
+		binary.BigEndian.PutUint64(buf[:], salt)
+		h.Write(buf[:])
+	}
+	n := new(big.Int).SetBytes(h.Sum(nil))
+	base := big.NewInt(int64(len(base62Alphabet)))
+	mod := new(big.Int)
+	code := make([]byte, shortCodeLength)
+	for i := range code {
+		n.DivMod(n, base, mod)
+		code[i] = base62Alphabet[mod.Int64()]
+	}
+	return string(code)
+}
@@ -0,0 +1,152 @@
+package api
+
+import (
+	"errors"
+	"time"
+
+	pb "github.com/bharat-rajani/grpc-products-demo/gen/proto/products"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrUnknownVendor is returned when a vendor is not part of the catalog.
+	ErrUnknownVendor = errors.New("unknown vendor")
+	// ErrVendorExists is returned when creating a vendor that is already part of the catalog.
+	ErrVendorExists = errors.New("vendor already exists")
+	// ErrProductTypeNotEmpty is returned when removing a product type that still lists products.
+	ErrProductTypeNotEmpty = errors.New("product type still lists products")
+	// ErrUnknownProductType is returned when a vendor does not offer a product type.
+	ErrUnknownProductType = errors.New("unknown product type")
+	// ErrProductNotFound is returned when a product is not part of the catalog.
+	ErrProductNotFound = errors.New("product not found")
+	// ErrIdempotencyKeyReused is returned when an idempotency key is sent again with a different product.
+	ErrIdempotencyKeyReused = errors.New("idempotency key was used for a different product")
+)
+
+// idempotencyWindow is how long a store remembers the idempotency key a
+// product was put with.
+const idempotencyWindow = 24 * time.Hour
+
+// Vendor is a cloud vendor listed in the catalog. Disabled vendors are kept
+// in the catalog but hidden from clients.
+type Vendor struct {
+	Name         string   `json:"name"`
+	DisplayName  string   `json:"displayName"`
+	Homepage     string   `json:"homepage"`
+	Enabled      bool     `json:"enabled"`
+	ProductTypes []string `json:"productTypes"`
+}
+
+func (v Vendor) clone() Vendor {
+	v.ProductTypes = append([]string(nil), v.ProductTypes...)
+	return v
+}
+
+func (v Vendor) toProto() *pb.Vendor {
+	return &pb.Vendor{
+		Name:         v.Name,
+		DisplayName:  v.DisplayName,
+		Homepage:     v.Homepage,
+		Enabled:      v.Enabled,
+		ProductTypes: v.ProductTypes,
+	}
+}
+
+// Product is a single catalog entry offered by a vendor under one of its
+// product types.
+type Product struct {
+	ID          string `json:"id"`
+	Vendor      string `json:"vendor"`
+	ProductType string `json:"productType"`
+	Title       string `json:"title"`
+	URL         string `json:"url"`
+}
+
+func (p Product) toProto(links *Shortener) *pb.ProdsPrep {
+	return &pb.ProdsPrep{
+		Id:       p.ID,
+		Title:    p.Title,
+		Url:      p.URL,
+		ShortUrl: links.ShortURL(p.URL),
+	}
+}
+
+func (p Product) catalogProto(links *Shortener) *pb.CatalogProduct {
+	return &pb.CatalogProduct{
+		Vendor:      p.Vendor,
+		ProductType: p.ProductType,
+		Product:     p.toProto(links),
+	}
+}
+
+// sameContent reports whether p and other only differ by their ID.
+func (p Product) sameContent(other Product) bool {
+	other.ID = p.ID
+	return p == other
+}
+
+// idempotentPut is a product put with an idempotency key, as remembered by a
+// store until the key expires.
+type idempotentPut struct {
+	Key     string    `json:"key"`
+	Time    time.Time `json:"time"`
+	Product Product   `json:"product"`
+}
+
+// Catalog is a complete copy of the vendors and products of a store.
+type Catalog struct {
+	Vendors  []Vendor  `json:"vendors"`
+	Products []Product `json:"products"`
+}
+
+// ProductStore is the catalog served by a ProductServer. Implementations must
+// be safe for concurrent use.
+type ProductStore interface {
+	// ListVendors returns all vendors in the catalog, disabled ones included.
+	ListVendors() ([]Vendor, error)
+	// GetVendor returns the vendor with the given name.
+	GetVendor(name string) (Vendor, error)
+	// CreateVendor adds a new vendor to the catalog.
+	CreateVendor(vendor Vendor) error
+	// UpdateVendor replaces the metadata of the vendor with the same name.
+	// Product types that still list products cannot be removed.
+	UpdateVendor(vendor Vendor) error
+	// DeleteVendor removes the vendor together with all of its products.
+	DeleteVendor(name string) error
+	// ListProductTypes returns the product types offered by vendor.
+	ListProductTypes(vendor string) ([]string, error)
+	// ListProducts returns the products of vendor under productType.
+	ListProducts(vendor, productType string) ([]Product, error)
+	// GetProduct returns the product with the given ID.
+	GetProduct(id string) (Product, error)
+	// PutProduct adds product to the catalog, registering its vendor and
+	// product type if they are not known yet. It returns the stored product
+	// carrying its newly assigned ID.
+	PutProduct(product Product) (Product, error)
+	// PutProductOnce is PutProduct for products sent with an idempotency key.
+	// Putting the same product with the same key again within
+	// idempotencyWindow stores nothing and returns the product stored the
+	// first time, with replayed set.
+	PutProductOnce(key string, product Product) (stored Product, replayed bool, err error)
+	// PreviousPut returns the product PutProductOnce stored with key within
+	// idempotencyWindow, if any, without storing anything.
+	PreviousPut(key string, product Product) (stored Product, found bool, err error)
+	// UpdateProduct replaces the product with the same ID, moving it if its
+	// vendor or product type changed.
+	UpdateProduct(product Product) error
+	// UpdateProductOnce is UpdateProduct for products sent with an
+	// idempotency key. Within idempotencyWindow, PutProductOnce and
+	// PreviousPut with the same key and product return the updated product.
+	UpdateProductOnce(key string, product Product) error
+	// DeleteProduct removes the product with the given ID. The idempotency
+	// keys of the products deleted, here and by DeleteVendor, are forgotten.
+	DeleteProduct(id string) error
+	// ReplaceCatalog atomically replaces all vendors and products with
+	// catalog, keeping the IDs of its products. The idempotency keys of the
+	// products it does not list unchanged are forgotten.
+	ReplaceCatalog(catalog Catalog) error
+}
+
+func newProductID() string {
+	return uuid.Must(uuid.NewRandom()).String()
+}
@@ -0,0 +1,102 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	pb "github.com/bharat-rajani/grpc-products-demo/gen/proto/products"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxTitleLength is the maximum number of characters of a product title.
+	maxTitleLength = 200
+	// maxIdempotencyKeyLength is the maximum number of bytes of an
+	// idempotency key.
+	maxIdempotencyKeyLength = 128
+)
+
+// validateProduct checks a product sent by SetVendorProducts and returns it
+// ready to be stored. The error is an InvalidArgument status carrying a
+// BadRequest detail with one violation per invalid field.
+func (pserv *ProductServer) validateProduct(req *pb.AdminClientRequestProducts) (Product, error) {
+	product := Product{
+		Vendor:      req.GetVendor(),
+		ProductType: req.GetProductType(),
+		Title:       strings.TrimSpace(req.GetProduct().GetTitle()),
+		URL:         req.GetProduct().GetUrl(),
+	}
+	var violations []*errdetails.BadRequest_FieldViolation
+	violate := func(field, format string, args ...interface{}) {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: fmt.Sprintf(format, args...),
+		})
+	}
+
+	vendor, err := pserv.store.GetVendor(product.Vendor)
+	switch {
+	case product.Vendor == "":
+		violate("vendor", "vendor is required")
+	case err == ErrUnknownVendor:
+		violate("vendor", "unknown vendor %q", product.Vendor)
+	case err != nil:
+		return Product{}, status.Errorf(codes.Internal, "could not get vendor: %v", err)
+	case product.ProductType == "":
+		violate("productType", "productType is required")
+	case !hasProductType(vendor, product.ProductType):
+		violate("productType", "%q is not a product type of %s, select between %s", product.ProductType, vendor.Name, strings.Join(vendor.ProductTypes, ", "))
+	}
+
+	switch {
+	case product.Title == "":
+		violate("product.title", "title is required")
+	case utf8.RuneCountInString(product.Title) > maxTitleLength:
+		violate("product.title", "title is longer than %d characters", maxTitleLength)
+	}
+
+	if target, err := url.Parse(product.URL); err != nil || !target.IsAbs() || target.Host == "" {
+		violate("product.url", "%q is not an absolute URL", product.URL)
+	}
+
+	if len(req.GetIdempotencyKey()) > maxIdempotencyKeyLength {
+		violate("idempotencyKey", "idempotency key is longer than %d bytes", maxIdempotencyKeyLength)
+	}
+
+	if len(violations) > 0 {
+		st := status.New(codes.InvalidArgument, fmt.Sprintf("invalid product %q: %d invalid fields", product.Title, len(violations)))
+		if detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations}); err == nil {
+			st = detailed
+		}
+		return Product{}, st.Err()
+	}
+	return product, nil
+}
+
+// validateCatalogProduct is validateProduct for the products created and
+// updated through the CRUD RPCs. The returned product keeps the ID of product.
+func (pserv *ProductServer) validateCatalogProduct(product Product) (Product, error) {
+	validated, err := pserv.validateProduct(&pb.AdminClientRequestProducts{
+		Vendor:      product.Vendor,
+		ProductType: product.ProductType,
+		Product:     &pb.ProdsPrep{Title: product.Title, Url: product.URL},
+	})
+	if err != nil {
+		return Product{}, err
+	}
+	validated.ID = product.ID
+	return validated, nil
+}
+
+func hasProductType(vendor Vendor, productType string) bool {
+	for _, prodType := range vendor.ProductTypes {
+		if prodType == productType {
+			return true
+		}
+	}
+	return false
+}
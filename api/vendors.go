@@ -0,0 +1,169 @@
+package api
+
+import (
+	"context"
+	"log"
+	"net/url"
+	"strings"
+
+	pb "github.com/bharat-rajani/grpc-products-demo/gen/proto/products"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (pserv *ProductServer) ListVendors(ctx context.Context, req *pb.ListVendorsRequest) (*pb.ListVendorsResponse, error) {
+	vendors, err := pserv.store.ListVendors()
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	response := &pb.ListVendorsResponse{}
+	for _, vendor := range vendors {
+		response.Vendors = append(response.Vendors, vendor.toProto())
+	}
+	return response, nil
+}
+
+func (pserv *ProductServer) CreateVendor(ctx context.Context, req *pb.Vendor) (*pb.Vendor, error) {
+	log.Printf("have received a request to create -> %s <- vendor", req.GetName())
+
+	if err := validateVendor(req); err != nil {
+		return nil, err
+	}
+
+	vendor := Vendor{
+		Name:         req.GetName(),
+		DisplayName:  req.GetDisplayName(),
+		Homepage:     req.GetHomepage(),
+		Enabled:      req.GetEnabled(),
+		ProductTypes: req.GetProductTypes(),
+	}
+	if vendor.DisplayName == "" {
+		vendor.DisplayName = titleCase(vendor.Name)
+	}
+
+	pserv.catalogMu.Lock()
+	defer pserv.catalogMu.Unlock()
+	if err := pserv.store.CreateVendor(vendor); err != nil {
+		return nil, storeError(err)
+	}
+	return vendor.toProto(), nil
+}
+
+// UpdateVendor updates the fields of the vendor listed in the update mask,
+// or its fields set to a non-empty value if the mask is empty. Enabled is
+// only updated when listed in the mask.
+func (pserv *ProductServer) UpdateVendor(ctx context.Context, req *pb.UpdateVendorRequest) (*pb.Vendor, error) {
+	update := req.GetVendor()
+	log.Printf("have received a request to update -> %s <- vendor", update.GetName())
+
+	if err := validateVendor(update); err != nil {
+		return nil, err
+	}
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		if update.GetDisplayName() != "" {
+			paths = append(paths, "displayName")
+		}
+		if update.GetHomepage() != "" {
+			paths = append(paths, "homepage")
+		}
+		if len(update.GetProductTypes()) > 0 {
+			paths = append(paths, "productTypes")
+		}
+	}
+
+	pserv.catalogMu.Lock()
+	defer pserv.catalogMu.Unlock()
+	vendor, err := pserv.store.GetVendor(update.GetName())
+	if err != nil {
+		return nil, storeError(err)
+	}
+	for _, path := range paths {
+		switch path {
+		case "displayName":
+			vendor.DisplayName = update.GetDisplayName()
+			if vendor.DisplayName == "" {
+				vendor.DisplayName = titleCase(vendor.Name)
+			}
+		case "homepage":
+			vendor.Homepage = update.GetHomepage()
+		case "enabled":
+			vendor.Enabled = update.GetEnabled()
+		case "productTypes":
+			// the store keeps the current product types if none are given
+			vendor.ProductTypes = update.GetProductTypes()
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "cannot update vendor field %q, select between displayName, homepage, enabled and productTypes", path)
+		}
+	}
+
+	if err := pserv.store.UpdateVendor(vendor); err != nil {
+		return nil, storeError(err)
+	}
+	return vendor.toProto(), nil
+}
+
+func (pserv *ProductServer) DeleteVendor(ctx context.Context, req *pb.DeleteVendorRequest) (*pb.DeleteVendorResponse, error) {
+	log.Printf("have received a request to delete -> %s <- vendor", req.GetName())
+
+	pserv.catalogMu.Lock()
+	defer pserv.catalogMu.Unlock()
+	vendor, err := pserv.store.GetVendor(req.GetName())
+	if err != nil {
+		return nil, storeError(err)
+	}
+	var products []Product
+	for _, prodType := range vendor.ProductTypes {
+		typeProducts, err := pserv.store.ListProducts(vendor.Name, prodType)
+		if err != nil {
+			return nil, storeError(err)
+		}
+		if len(typeProducts) > 0 && !req.GetForce() {
+			return nil, status.Errorf(codes.FailedPrecondition, "vendor %s still lists %s products, delete them first or force the deletion", vendor.Name, prodType)
+		}
+		products = append(products, typeProducts...)
+	}
+
+	if err := pserv.store.DeleteVendor(vendor.Name); err != nil {
+		return nil, storeError(err)
+	}
+	for _, product := range products {
+		pserv.index.remove(product.ID)
+	}
+	return &pb.DeleteVendorResponse{}, nil
+}
+
+// checkVendor returns an InvalidArgument status listing the enabled vendors
+// if name is not one of them.
+func (pserv *ProductServer) checkVendor(name string) error {
+	vendors, err := pserv.store.ListVendors()
+	if err != nil {
+		return storeError(err)
+	}
+
+	var enabled []string
+	for _, vendor := range vendors {
+		if !vendor.Enabled {
+			continue
+		}
+		if vendor.Name == name {
+			return nil
+		}
+		enabled = append(enabled, vendor.Name)
+	}
+	return status.Errorf(codes.InvalidArgument, "Wrong vendor, select between %s", strings.Join(enabled, ", "))
+}
+
+func validateVendor(req *pb.Vendor) error {
+	if req.GetName() == "" || strings.TrimSpace(req.GetName()) != req.GetName() {
+		return status.Error(codes.InvalidArgument, "vendor name is required and cannot start or end with spaces")
+	}
+	if req.GetHomepage() != "" {
+		homepage, err := url.Parse(req.GetHomepage())
+		if err != nil || !homepage.IsAbs() {
+			return status.Errorf(codes.InvalidArgument, "vendor homepage %q is not an absolute URL", req.GetHomepage())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// wal is an append-only log of JSON records, one per line. Every append is
+// synced to disk before it returns.
+type wal struct {
+	mu   sync.Mutex
+	path string
+	f    *os.File
+}
+
+func openWAL(path string) (*wal, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &wal{path: path, f: f}, nil
+}
+
+func (w *wal) append(record interface{}) error {
+	return w.appendAll([]interface{}{record})
+}
+
+// appendAll appends records with a single write, synced once.
+func (w *wal) appendAll(records []interface{}) error {
+	var buf bytes.Buffer
+	for _, record := range records {
+		line, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, err := w.f.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	return w.f.Sync()
+}
+
+// replay calls fn with every record in the log. A torn last line, left by a
+// crash in the middle of an append, is discarded and cut from the file.
+func (w *wal) replay(fn func(json.RawMessage) error) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, err := w.f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	reader := bufio.NewReader(w.f)
+	var good int64
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			if len(bytes.TrimSpace(line)) > 0 {
+				return w.f.Truncate(good)
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(json.RawMessage(line)); err != nil {
+			return err
+		}
+		good += int64(len(line))
+	}
+}
+
+// truncate empties the log, typically right after a snapshot was written.
+func (w *wal) truncate() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err := w.f.Truncate(0); err != nil {
+		return err
+	}
+	return w.f.Sync()
+}
+
+func (w *wal) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.f.Close()
+}
+
+// writeFileAtomic replaces path with data so that readers see either the old
+// or the new content, even across crashes.
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err := tmp.Chmod(0644); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return err
+	}
+	dir, err := os.Open(filepath.Dir(path))
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
@@ -0,0 +1,99 @@
+package api
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func replayAll(t *testing.T, w *wal) []int {
+	t.Helper()
+	var records []int
+	err := w.replay(func(line json.RawMessage) error {
+		var record int
+		if err := json.Unmarshal(line, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("replay() = %v", err)
+	}
+	return records
+}
+
+func TestWALCutsTornLastLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.wal")
+	w, err := openWAL(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, record := range []int{1, 2} {
+		if err := w.append(record); err != nil {
+			t.Fatal(err)
+		}
+	}
+	good, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a crash in the middle of appending the third record
+	if _, err := w.f.Write([]byte("3")); err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+
+	w, err = openWAL(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	if got := replayAll(t, w); len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Fatalf("replayed %v, want [1 2]", got)
+	}
+	cut, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cut.Size() != good.Size() {
+		t.Fatalf("log is %d bytes after replay, want the torn line cut to %d", cut.Size(), good.Size())
+	}
+
+	if err := w.append(4); err != nil {
+		t.Fatal(err)
+	}
+	if got := replayAll(t, w); len(got) != 3 || got[2] != 4 {
+		t.Fatalf("replayed %v after appending, want [1 2 4]", got)
+	}
+}
+
+func TestWALTruncate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.wal")
+	w, err := openWAL(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	if err := w.append(1); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.truncate(); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.append(2); err != nil {
+		t.Fatal(err)
+	}
+	if got := replayAll(t, w); len(got) != 1 || got[0] != 2 {
+		t.Fatalf("replayed %v, want [2]", got)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "2\n" {
+		t.Fatalf("log holds %q, want %q", data, "2\n")
+	}
+}
@@ -1,7 +1,11 @@
 package main
 
 import (
+	"bufio"
 	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
 	"encoding/json"
 	"flag"
 	"fmt"
@@ -9,20 +13,39 @@ import (
 	"log"
 	"math/rand"
 	"net"
+	"net/url"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	pb "github.com/bharat-rajani/grpc-products-demo/gen/proto/products"
 	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
-	addr = flag.String("addr", "localhost", "The address of the server to connect to")
-	port = flag.String("port", "8080", "The port to connect to")
+	addr      = flag.String("addr", "localhost", "The address of the server to connect to")
+	port      = flag.String("port", "8080", "The port to connect to")
+	follow    = flag.Bool("follow", false, "Keep getprods streaming newly set products instead of exiting after the current catalog")
+	dryRun    = flag.Bool("dry-run", false, "Make import and restore only check their input, without storing it")
+	replace   = flag.Bool("replace", false, "Make restore replace the whole catalog, or the vendor of a single vendor export, instead of merging the export into it")
+	format    = flag.String("format", "", "Format of the import and export files, csv or jsonl, guessed from the file extension if empty")
+	since     = flag.Duration("since", 0, "Make chat replay the room history of the last duration, e.g. 1h")
+	sinceID   = flag.String("since-id", "", "Make chat replay the room history posted after this message id")
+	duration  = flag.Duration("duration", 0, "How long mute and kick last, until unmute or only disconnecting if 0")
+	moderator = flag.String("moderator", os.Getenv("USER"), "Name logged with the mute, unmute and kick actions")
 )
 
+// setprodsAttempts is how many times setprods sends a batch of products
+// before giving up on a server that keeps dropping the stream.
+const setprodsAttempts = 3
+
 var LetterRunes []rune = []rune("3ABCDEFGHIJKLMNOPQRSTUVWXYZ")
 
 func main() {
@@ -31,7 +54,7 @@ func main() {
 	flag.Parse()
 
 	if flag.NArg() < 1 {
-		fmt.Fprintln(os.Stderr, "missing command: getprodtypes or getprods")
+		fmt.Fprintln(os.Stderr, "missing command: getprodtypes, getprods, setprods, import, export, restore, chat, participants, mute, unmute, kick, modlog or listvendors")
 		os.Exit(1)
 	}
 
@@ -59,6 +82,26 @@ func main() {
 		err = getprods(ctx, client, flag.Arg(1), flag.Arg(2))
 	case "setprods":
 		err = setprods(ctx, client, flag.Arg(1), flag.Arg(2))
+	case "import":
+		err = importprods(ctx, client, flag.Arg(1))
+	case "export":
+		err = exportprods(ctx, client, flag.Arg(1))
+	case "restore":
+		err = restoreprods(ctx, client, flag.Arg(1))
+	case "chat":
+		err = chat(ctx, client, flag.Arg(1), flag.Arg(2))
+	case "participants":
+		err = participants(ctx, client, flag.Arg(1))
+	case "mute", "unmute", "kick":
+		var reason string
+		if flag.NArg() > 3 {
+			reason = strings.Join(flag.Args()[3:], " ")
+		}
+		err = moderate(ctx, client, cmd, flag.Arg(1), flag.Arg(2), reason)
+	case "modlog":
+		err = modlog(ctx, client, flag.Arg(1))
+	case "listvendors":
+		err = listvendors(ctx, client)
 	default:
 		err = fmt.Errorf("unknown subcommand %s", cmd)
 	}
@@ -74,7 +117,7 @@ func getprodtypes(ctx context.Context, client pb.ProductServiceClient, vendor st
 	log.Printf("requesting all product types from vendor: %s", vendor)
 
 	if vendor == "" {
-		return fmt.Errorf("Vendor arg is missing, select between available cloud vendors: google, aws, oracle")
+		return fmt.Errorf("Vendor arg is missing, list the available cloud vendors with: $client listvendors")
 	}
 
 	requestProdType := pb.ClientRequestType{
@@ -92,12 +135,36 @@ func getprodtypes(ctx context.Context, client pb.ProductServiceClient, vendor st
 		return fmt.Errorf("Could not get the products: %v", err)
 	}
 
-	fmt.Printf("%s cloud products type are: %s\n", vendor, response.GetProductType())
+	fmt.Printf("%s cloud products type are:\n", vendor)
+	for _, prodType := range response.GetProductTypes() {
+		fmt.Printf("  %s (%s): %d products\n", prodType.GetName(), prodType.GetDisplayName(), prodType.GetProductCount())
+	}
 
 	return nil
 
 }
 
+func listvendors(ctx context.Context, client pb.ProductServiceClient) error {
+
+	response, err := client.ListVendors(ctx, &pb.ListVendorsRequest{})
+	if err != nil {
+		if errStatus, ok := status.FromError(err); ok {
+			return status.Errorf(errStatus.Code(), "error while calling client.ListVendors() method: %v ", errStatus.Message())
+		}
+		return fmt.Errorf("Could not list the vendors: %v", err)
+	}
+
+	for _, vendor := range response.GetVendors() {
+		state := "enabled"
+		if !vendor.GetEnabled() {
+			state = "disabled"
+		}
+		fmt.Printf("%s (%s, %s): %s, product types: %s\n", vendor.GetName(), vendor.GetDisplayName(), state, vendor.GetHomepage(), strings.Join(vendor.GetProductTypes(), ", "))
+	}
+
+	return nil
+}
+
 func getprods(ctx context.Context, client pb.ProductServiceClient, vendor string, prodType string) error {
 
 	log.Printf("requesting all %s products from %s", prodType, vendor)
@@ -109,6 +176,10 @@ func getprods(ctx context.Context, client pb.ProductServiceClient, vendor string
 	requestProd := pb.ClientRequestProducts{
 		Vendor:      vendor,
 		ProductType: prodType,
+		Mode:        pb.StreamMode_STREAM_MODE_SNAPSHOT,
+	}
+	if *follow {
+		requestProd.Mode = pb.StreamMode_STREAM_MODE_FOLLOW
 	}
 
 	stream, err := client.GetVendorProducts(ctx, &requestProd)
@@ -130,7 +201,7 @@ func getprods(ctx context.Context, client pb.ProductServiceClient, vendor string
 			}
 			return fmt.Errorf("error while receiving the stream for client.GetVendorProds: %v", err)
 		}
-		fmt.Printf("Title: %s, Url: %s,  ShortUrl: %s\n", product.GetProduct().GetTitle(), product.GetProduct().GetUrl(), product.GetProduct().GetShortUrl())
+		fmt.Printf("Id: %s, Title: %s, Url: %s,  ShortUrl: %s\n", product.GetProduct().GetId(), product.GetProduct().GetTitle(), product.GetProduct().GetUrl(), product.GetProduct().GetShortUrl())
 	}
 
 	return nil
@@ -146,7 +217,6 @@ func setprods(ctx context.Context, client pb.ProductServiceClient, vendor string
 
 	stream, err := client.SetVendorProducts(ctx)
 	if err != nil {
-		fmt.Errorf(err.Error())
 		return err
 	}
 
@@ -159,7 +229,7 @@ func setprods(ctx context.Context, client pb.ProductServiceClient, vendor string
 		productNames := make([]string, 0, cnt)
 
 		for i := 0; i < cnt; i++ {
-			productNames = append(productNames, genRandomStr(3))
+			productNames = append(productNames, genRandomStr(6))
 		}
 
 		if len(productNames) == 0 || productNames[0] == "" {
@@ -170,45 +240,598 @@ func setprods(ctx context.Context, client pb.ProductServiceClient, vendor string
 			}
 		}
 
-		totalL += len(productNames)
-
-		time.Sleep(time.Millisecond * 400)
+		// every product gets its idempotency key once, so resending the
+		// batch on a new stream cannot store a product twice
+		batch := make([]*pb.AdminClientRequestProducts, 0, len(productNames))
 		for _, prod := range productNames {
-			fmt.Println("Setting product: ", prod)
-			id := uuid.Must(uuid.NewRandom()).String()
-
-			requestProd := pb.AdminClientRequestProducts{
+			batch = append(batch, &pb.AdminClientRequestProducts{
 				Product: &pb.ProdsPrep{
-					Title:    prod,
-					Url:      "sample Url",
-					ShortUrl: "https://made-up-url.com/" + id[:6],
+					Title: prod,
+					Url:   "https://example.com/products/" + prod,
 				},
-				Vendor:      vendor,
-				ProductType: prodType,
-			}
-
-			// fmt.Println(requestProd)
-			// time.Sleep(time.Millisecond * 600)
+				Vendor:         vendor,
+				ProductType:    prodType,
+				IdempotencyKey: uuid.Must(uuid.NewRandom()).String(),
+			})
+		}
+		totalL += len(batch)
 
-			if err := stream.Send(&requestProd); err != nil {
-				log.Println("Error while sending: %v", err)
+		time.Sleep(time.Millisecond * 400)
+		for attempt := 1; ; attempt++ {
+			err := sendProducts(stream, batch)
+			if err == nil {
+				break
+			}
+			if status.Code(err) != codes.Unavailable || attempt == setprodsAttempts {
+				printBadRequest(err)
+				log.Printf("Error while sending: %v", err)
 				log.Println("Total products sent: ", totalL)
 				return err
 			}
+			log.Printf("Stream dropped, resending %d products: %v", len(batch), err)
+			time.Sleep(time.Duration(attempt) * time.Second)
+			if stream, err = client.SetVendorProducts(ctx); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sendProducts sends batch on stream. If the server ended the stream, the
+// returned error is the status telling why.
+func sendProducts(stream pb.ProductService_SetVendorProductsClient, batch []*pb.AdminClientRequestProducts) error {
+	for _, requestProd := range batch {
+		fmt.Println("Setting product: ", requestProd.GetProduct().GetTitle())
+		if err := stream.Send(requestProd); err != nil {
+			if err == io.EOF {
+				_, err = stream.CloseAndRecv()
+			}
+			return err
+		}
+
+		out, err := json.Marshal(requestProd)
+		if err != nil {
+			log.Println(err.Error())
+		}
+		log.Printf("%v Sent", string(out))
+	}
+	return nil
+}
+
+// chat joins the chat room of vendor as sender, posting the lines read from
+// stdin and printing the messages of the room.
+func chat(ctx context.Context, client pb.ProductServiceClient, vendor string, sender string) error {
+	if vendor == "" || sender == "" {
+		return fmt.Errorf("You need both, vendor and name args. Example command: $client chat aws alice")
+	}
+
+	stream, err := client.ChatVendorSales(ctx)
+	if err != nil {
+		return err
+	}
+	join := &pb.ChatMessage{Room: vendor, Sender: sender, ReplaySinceId: *sinceID, Event: &pb.ChatMessage_Join{Join: &pb.ChatJoin{}}}
+	if *since > 0 {
+		join.ReplaySince = timestamppb.New(time.Now().Add(-*since))
+	}
+	if err := stream.Send(join); err != nil {
+		return err
+	}
+
+	go func() {
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			if strings.TrimSpace(scanner.Text()) == "" {
+				continue
+			}
+			text := &pb.ChatMessage{Event: &pb.ChatMessage_MessageContent{MessageContent: scanner.Text()}}
+			if err := stream.Send(text); err != nil {
+				return
+			}
+		}
+		stream.CloseSend()
+	}()
+
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error while receiving the chat messages: %v", err)
+		}
+		at := msg.GetTimestamp().AsTime().Local().Format("15:04:05")
+		switch event := msg.GetEvent().(type) {
+		case *pb.ChatMessage_MessageContent:
+			fmt.Printf("[%s] %s: %s\n", at, msg.GetSender(), event.MessageContent)
+		case *pb.ChatMessage_Join:
+			fmt.Printf("[%s] * %s joined\n", at, msg.GetSender())
+		case *pb.ChatMessage_Leave:
+			fmt.Printf("[%s] * %s left\n", at, msg.GetSender())
+		case *pb.ChatMessage_Typing:
+			if event.Typing.GetTyping() {
+				fmt.Printf("[%s] * %s is typing\n", at, msg.GetSender())
+			}
+		case *pb.ChatMessage_Moderation:
+			fmt.Printf("[%s] * %s\n", at, moderation(event.Moderation))
+		}
+	}
+}
+
+// participants prints who is connected to the chat room of vendor, or to
+// every room if vendor is empty.
+func participants(ctx context.Context, client pb.ProductServiceClient, vendor string) error {
+	response, err := client.ListRoomParticipants(ctx, &pb.ListRoomParticipantsRequest{Room: vendor})
+	if err != nil {
+		return fmt.Errorf("Could not list the chat participants: %v", err)
+	}
+	for _, room := range response.GetRooms() {
+		fmt.Printf("%s:\n", room.GetRoom())
+		for _, p := range room.GetParticipants() {
+			fmt.Printf("  %s, since %s, %d connections\n", p.GetName(), p.GetJoinedAt().AsTime().Local().Format("15:04:05"), p.GetConnections())
+		}
+	}
+	return nil
+}
+
+// moderate mutes, unmutes or kicks name from the chat room of vendor.
+func moderate(ctx context.Context, client pb.ProductServiceClient, action string, vendor string, name string, reason string) error {
+	if vendor == "" || name == "" {
+		return fmt.Errorf("You need both, vendor and name args. Example command: $client -duration 10m %s aws bob spamming", action)
+	}
+
+	req := &pb.ModerateChatRequest{
+		Room:            vendor,
+		Name:            name,
+		Moderator:       *moderator,
+		Reason:          reason,
+		DurationSeconds: int64(duration.Seconds()),
+	}
+	var record *pb.ModerationRecord
+	var err error
+	switch action {
+	case "mute":
+		record, err = client.MuteChatParticipant(ctx, req)
+	case "unmute":
+		record, err = client.UnmuteChatParticipant(ctx, req)
+	default:
+		record, err = client.KickChatParticipant(ctx, req)
+	}
+	if err != nil {
+		return fmt.Errorf("Could not %s %s: %v", action, name, err)
+	}
+	fmt.Println(moderation(record))
+	return nil
+}
+
+// modlog prints the moderation actions taken in the chat room of vendor, or
+// in every room if vendor is empty.
+func modlog(ctx context.Context, client pb.ProductServiceClient, vendor string) error {
+	response, err := client.ListModerationLog(ctx, &pb.ListModerationLogRequest{Room: vendor})
+	if err != nil {
+		return fmt.Errorf("Could not list the moderation log: %v", err)
+	}
+	for _, record := range response.GetRecords() {
+		fmt.Printf("[%s] %s: %s\n", record.GetTimestamp().AsTime().Local().Format(time.RFC3339), record.GetRoom(), moderation(record))
+	}
+	return nil
+}
 
-			out, err := json.Marshal(requestProd)
+// moderation describes a moderation record.
+func moderation(record *pb.ModerationRecord) string {
+	var text string
+	switch record.GetAction() {
+	case pb.ModerationAction_MODERATION_ACTION_REJECT:
+		return "your message was rejected: " + record.GetReason()
+	case pb.ModerationAction_MODERATION_ACTION_MUTE:
+		text = record.GetName() + " was muted"
+	case pb.ModerationAction_MODERATION_ACTION_UNMUTE:
+		text = record.GetName() + " was unmuted"
+	case pb.ModerationAction_MODERATION_ACTION_KICK:
+		text = record.GetName() + " was kicked"
+	default:
+		text = record.GetName() + " was moderated"
+	}
+	if record.GetModerator() != "" {
+		text += " by " + record.GetModerator()
+	}
+	if record.GetExpires() != nil {
+		text += " until " + record.GetExpires().AsTime().Local().Format("15:04:05")
+	}
+	if record.GetReason() != "" {
+		text += ": " + record.GetReason()
+	}
+	return text
+}
+
+// importRow is a product to import, as read from a CSV or JSON Lines file.
+type importRow struct {
+	line        int
+	Vendor      string `json:"vendor"`
+	ProductType string `json:"productType"`
+	Title       string `json:"title"`
+	URL         string `json:"url"`
+}
+
+// importprods streams the products listed in path, or stdin if path is empty
+// or "-", to SetVendorProducts. Invalid rows are reported and skipped.
+func importprods(ctx context.Context, client pb.ProductServiceClient, path string) error {
+	in := os.Stdin
+	if path != "" && path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	rowFormat := fileFormat(path)
+	var rows []importRow
+	var err error
+	switch rowFormat {
+	case "csv":
+		rows, err = readCSVRows(in)
+	case "jsonl":
+		rows, err = readJSONRows(in)
+	default:
+		return fmt.Errorf("unknown import format %s, select between csv and jsonl", rowFormat)
+	}
+	if err != nil {
+		return err
+	}
+
+	response, err := client.ListVendors(ctx, &pb.ListVendorsRequest{})
+	if err != nil {
+		return fmt.Errorf("Could not list the vendors: %v", err)
+	}
+	productTypes := make(map[string]bool)
+	for _, vendor := range response.GetVendors() {
+		for _, prodType := range vendor.GetProductTypes() {
+			productTypes[vendor.GetName()+"/"+prodType] = true
+		}
+	}
+
+	var valid []importRow
+	for _, row := range rows {
+		if problem := checkImportRow(row, productTypes); problem != "" {
+			fmt.Fprintf(os.Stderr, "line %d: %s\n", row.line, problem)
+			continue
+		}
+		valid = append(valid, row)
+	}
+	invalid := len(rows) - len(valid)
+	fmt.Printf("%d rows read, %d valid, %d invalid\n", len(rows), len(valid), invalid)
+	if *dryRun || len(valid) == 0 {
+		if invalid > 0 {
+			return fmt.Errorf("%d invalid rows", invalid)
+		}
+		return nil
+	}
+
+	// IngestProducts acknowledges every row, so a row the server rejects is
+	// reported against its line without stopping the import
+	stream, err := client.IngestProducts(ctx)
+	if err != nil {
+		return err
+	}
+	sent := make(chan error, 1)
+	go func() {
+		for _, row := range valid {
+			err := stream.Send(&pb.AdminClientRequestProducts{
+				Vendor:      row.Vendor,
+				ProductType: row.ProductType,
+				Product:     &pb.ProdsPrep{Title: row.Title, Url: row.URL},
+				// importing the same file again within a day stores nothing twice
+				IdempotencyKey: importKey(row),
+			})
 			if err != nil {
-				log.Println(err.Error())
+				// the receiving side gets the status of the stream
+				sent <- err
+				return
+			}
+		}
+		sent <- stream.CloseSend()
+	}()
+
+	var imported, rejected int
+	for {
+		result, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("import stopped after %d of %d rows: %v", imported+rejected, len(valid), err)
+		}
+		if result.GetIndex() < 0 || result.GetIndex() >= int64(len(valid)) {
+			return fmt.Errorf("unexpected result for row %d", result.GetIndex())
+		}
+		row := valid[result.GetIndex()]
+		if ingestErr := result.GetError(); ingestErr != nil {
+			rejected++
+			fmt.Fprintf(os.Stderr, "line %d: %s\n", row.line, ingestErr.GetMessage())
+			for _, violation := range ingestErr.GetFieldViolations() {
+				fmt.Fprintf(os.Stderr, "line %d: invalid %s: %s\n", row.line, violation.GetField(), violation.GetDescription())
 			}
+			continue
+		}
+		imported++
+		if (imported+rejected)%100 == 0 {
+			fmt.Printf("handled %d/%d products\n", imported+rejected, len(valid))
+		}
+	}
+	if err := <-sent; err != nil && err != io.EOF {
+		return err
+	}
+	fmt.Printf("imported %d products, %d rejected by the server\n", imported, rejected)
+	if invalid+rejected > 0 {
+		return fmt.Errorf("%d rows not imported", invalid+rejected)
+	}
+	return nil
+}
+
+// exportprods writes the catalog to path, or stdout if path is empty or "-",
+// as the JSON Lines ExportCatalog records or as CSV products.
+func exportprods(ctx context.Context, client pb.ProductServiceClient, path string) error {
+	rowFormat := fileFormat(path)
+	if rowFormat != "csv" && rowFormat != "jsonl" {
+		return fmt.Errorf("unknown export format %s, select between csv and jsonl", rowFormat)
+	}
 
-			log.Printf("%v Sent", string(out))
+	stream, err := client.ExportCatalog(ctx, &pb.ExportCatalogRequest{})
+	if err != nil {
+		return fmt.Errorf("Could not export the catalog: %v", err)
+	}
+
+	out := os.Stdout
+	tmpPath := ""
+	if path != "" && path != "-" {
+		// the export only replaces path once it is complete
+		tmpPath = path + ".tmp"
+		f, err := os.Create(tmpPath)
+		if err != nil {
+			return err
 		}
+		defer os.Remove(tmpPath)
+		defer f.Close()
+		out = f
+	}
+	w := bufio.NewWriter(out)
+	csvWriter := csv.NewWriter(w)
+	if rowFormat == "csv" {
+		fmt.Fprintln(os.Stderr, "CSV exports only list the products, use jsonl to keep the vendors")
+		csvWriter.Write([]string{"id", "vendor", "productType", "title", "url"})
+	}
 
+	var vendors, products int32
+	var trailer *pb.ExportTrailer
+	for trailer == nil {
+		record, err := stream.Recv()
+		if err == io.EOF {
+			return fmt.Errorf("export ended without its trailer")
+		}
+		if err != nil {
+			return fmt.Errorf("error while receiving the export: %v", err)
+		}
+		switch r := record.GetRecord().(type) {
+		case *pb.ExportRecord_Header:
+			fmt.Fprintf(os.Stderr, "exporting %d vendors and %d products, format version %d\n", r.Header.GetVendorCount(), r.Header.GetProductCount(), r.Header.GetFormatVersion())
+		case *pb.ExportRecord_Vendor:
+			vendors++
+		case *pb.ExportRecord_Product:
+			products++
+			if products%1000 == 0 {
+				fmt.Fprintf(os.Stderr, "exported %d products\n", products)
+			}
+			if rowFormat == "csv" {
+				product := r.Product
+				csvWriter.Write([]string{product.GetProduct().GetId(), product.GetVendor(), product.GetProductType(), product.GetProduct().GetTitle(), product.GetProduct().GetUrl()})
+			}
+		case *pb.ExportRecord_Trailer:
+			trailer = r.Trailer
+		}
+		if rowFormat == "jsonl" {
+			line, err := protojson.Marshal(record)
+			if err != nil {
+				return err
+			}
+			w.Write(append(line, '\n'))
+		}
+	}
+	if vendors != trailer.GetVendorCount() || products != trailer.GetProductCount() {
+		return fmt.Errorf("export is incomplete, received %d vendors and %d products instead of %d and %d", vendors, products, trailer.GetVendorCount(), trailer.GetProductCount())
 	}
 
+	csvWriter.Flush()
+	if err := csvWriter.Error(); err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if tmpPath != "" {
+		if err := out.Close(); err != nil {
+			return err
+		}
+		if err := os.Rename(tmpPath, path); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintf(os.Stderr, "exported %d vendors and %d products, checksum %s\n", vendors, products, trailer.GetChecksum())
 	return nil
 }
 
+// restoreprods sends back the JSON Lines export in path, or stdin if path is
+// empty or "-", through RestoreCatalog.
+func restoreprods(ctx context.Context, client pb.ProductServiceClient, path string) error {
+	in := os.Stdin
+	if path != "" && path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	stream, err := client.RestoreCatalog(ctx)
+	if err != nil {
+		return err
+	}
+	mode := pb.RestoreMode_RESTORE_MODE_MERGE
+	if *replace {
+		mode = pb.RestoreMode_RESTORE_MODE_REPLACE
+	}
+
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		record := &pb.ExportRecord{}
+		if err := protojson.Unmarshal(scanner.Bytes(), record); err != nil {
+			return fmt.Errorf("line %d: %v", line, err)
+		}
+		err := stream.Send(&pb.RestoreCatalogRequest{Mode: mode, DryRun: *dryRun, Record: record})
+		if err == io.EOF {
+			// the server ended the stream, its status tells why
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if line%1000 == 0 {
+			fmt.Fprintf(os.Stderr, "sent %d records\n", line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	report, err := stream.CloseAndRecv()
+	if err != nil {
+		return fmt.Errorf("restore failed: %v", err)
+	}
+	if report.GetApplied() {
+		fmt.Println("Catalog restored:")
+	} else {
+		fmt.Println("Dry run, the restore would change:")
+	}
+	fmt.Printf("  vendors added: %s\n", strings.Join(report.GetVendorsAdded(), ", "))
+	fmt.Printf("  vendors changed: %s\n", strings.Join(report.GetVendorsChanged(), ", "))
+	fmt.Printf("  vendors removed: %s\n", strings.Join(report.GetVendorsRemoved(), ", "))
+	fmt.Printf("  products added: %d, changed: %d, removed: %d\n", report.GetProductsAdded(), report.GetProductsChanged(), report.GetProductsRemoved())
+	return nil
+}
+
+// fileFormat returns the -format flag, or guesses the format of path from its
+// extension, csv unless it ends in .jsonl or .json.
+func fileFormat(path string) string {
+	if *format != "" {
+		return *format
+	}
+	if ext := filepath.Ext(path); ext == ".jsonl" || ext == ".json" {
+		return "jsonl"
+	}
+	return "csv"
+}
+
+// readCSVRows reads CSV rows whose header names the vendor, productType,
+// title and url columns, in any order.
+func readCSVRows(in io.Reader) ([]importRow, error) {
+	reader := csv.NewReader(in)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading CSV header: %v", err)
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"vendor", "producttype", "title", "url"} {
+		if _, found := columns[name]; !found {
+			return nil, fmt.Errorf("CSV header lacks the %s column", name)
+		}
+	}
+
+	var rows []importRow
+	// the header is line 1, quoted fields are not expected to span lines
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		field := func(name string) string {
+			if i := columns[name]; i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		rows = append(rows, importRow{
+			line:        line,
+			Vendor:      field("vendor"),
+			ProductType: field("producttype"),
+			Title:       field("title"),
+			URL:         field("url"),
+		})
+	}
+}
+
+// readJSONRows reads one JSON object per line, skipping blank lines.
+func readJSONRows(in io.Reader) ([]importRow, error) {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	var rows []importRow
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		row := importRow{line: line}
+		if err := json.Unmarshal(scanner.Bytes(), &row); err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		row.Title = strings.TrimSpace(row.Title)
+		rows = append(rows, row)
+	}
+	return rows, scanner.Err()
+}
+
+// checkImportRow returns why the server would reject row, or an empty string.
+func checkImportRow(row importRow, productTypes map[string]bool) string {
+	switch {
+	case row.Vendor == "" || row.ProductType == "" || row.Title == "":
+		return "vendor, productType and title are required"
+	case !productTypes[row.Vendor+"/"+row.ProductType]:
+		return fmt.Sprintf("%s is not a product type of %s", row.ProductType, row.Vendor)
+	}
+	if target, err := url.Parse(row.URL); err != nil || !target.IsAbs() || target.Host == "" {
+		return fmt.Sprintf("url %q is not an absolute URL", row.URL)
+	}
+	return ""
+}
+
+// importKey derives the idempotency key of row from its content.
+func importKey(row importRow) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{row.Vendor, row.ProductType, row.Title, row.URL}, "\x00")))
+	return "import-" + hex.EncodeToString(sum[:16])
+}
+
+// printBadRequest prints the field violations carried by an InvalidArgument
+// status, if any.
+func printBadRequest(err error) {
+	for _, detail := range status.Convert(err).Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.GetFieldViolations() {
+				fmt.Printf("Invalid %s: %s\n", violation.GetField(), violation.GetDescription())
+			}
+		}
+	}
+}
+
 func genRandomStr(length int) string {
 	strArr := make([]rune, length)
 	for i := range strArr {
@@ -12,7 +12,9 @@ async def main():
     async with grpc.aio.insecure_channel(GRPC_HOST_PORT) as channel:
         stub = products_pb2_grpc.ProductServiceStub(channel)
         response = await stub.GetVendorProductTypes(products_pb2.ClientRequestType(vendor='google'),wait_for_ready=True,timeout=4)
-    print("Python Product client received: " + response.productType)
+    print("Python Product client received:")
+    for product_type in response.productTypes:
+        print("  %s (%s): %d products" % (product_type.name, product_type.displayName, product_type.productCount))
 
 
 if __name__ == '__main__':
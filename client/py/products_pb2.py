@@ -2,6 +2,7 @@
 # Generated by the protocol buffer compiler.  DO NOT EDIT!
 # source: products.proto
 """Generated protocol buffer code."""
+from google.protobuf.internal import enum_type_wrapper
 from google.protobuf import descriptor as _descriptor
 from google.protobuf import message as _message
 from google.protobuf import reflection as _reflection
@@ -11,6 +12,8 @@ from google.protobuf import symbol_database as _symbol_database
 _sym_db = _symbol_database.Default()
 
 
+from google.protobuf import field_mask_pb2 as google_dot_protobuf_dot_field__mask__pb2
+from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
 
 
 DESCRIPTOR = _descriptor.FileDescriptor(
@@ -19,9 +22,140 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=None,
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x0eproducts.proto\x12\x0bproducts.v1\"#\n\x11\x43lientRequestType\x12\x0e\n\x06vendor\x18\x01 \x01(\t\")\n\x12\x43lientResponseType\x12\x13\n\x0bproductType\x18\x01 \x01(\t\"<\n\x15\x43lientRequestProducts\x12\x0e\n\x06vendor\x18\x01 \x01(\t\x12\x13\n\x0bproductType\x18\x02 \x01(\t\"A\n\x16\x43lientResponseProducts\x12\'\n\x07product\x18\x01 \x01(\x0b\x32\x16.products.v1.ProdsPrep\"9\n\tProdsPrep\x12\r\n\x05title\x18\x01 \x01(\t\x12\x0b\n\x03url\x18\x02 \x01(\t\x12\x10\n\x08shortUrl\x18\x03 \x01(\t\"j\n\x1a\x41\x64minClientRequestProducts\x12\'\n\x07product\x18\x01 \x01(\x0b\x32\x16.products.v1.ProdsPrep\x12\x0e\n\x06vendor\x18\x02 \x01(\t\x12\x13\n\x0bproductType\x18\x03 \x01(\t\"\x1d\n\x0cProductCount\x12\r\n\x05\x63ount\x18\x01 \x01(\x05\x32\xa5\x02\n\x0eProductService\x12X\n\x15GetVendorProductTypes\x12\x1e.products.v1.ClientRequestType\x1a\x1f.products.v1.ClientResponseType\x12^\n\x11GetVendorProducts\x12\".products.v1.ClientRequestProducts\x1a#.products.v1.ClientResponseProducts0\x01\x12Y\n\x11SetVendorProducts\x12\'.products.v1.AdminClientRequestProducts\x1a\x19.products.v1.ProductCount(\x01\x62\x06proto3'
+  serialized_pb=b'\n\x0eproducts.proto\x12\x0bproducts.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"#\n\x11\x43lientRequestType\x12\x0e\n\x06vendor\x18\x01 \x01(\t\"]\n\x12\x43lientResponseType\x12\x13\n\x0bproductType\x18\x01 \x01(\t\x12\x32\n\x0cproductTypes\x18\x02 \x03(\x0b\x32\x1c.products.v1.ProductTypeInfo\"J\n\x0fProductTypeInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x13\n\x0b\x64isplayName\x18\x02 \x01(\t\x12\x14\n\x0cproductCount\x18\x03 \x01(\x05\"c\n\x15\x43lientRequestProducts\x12\x0e\n\x06vendor\x18\x01 \x01(\t\x12\x13\n\x0bproductType\x18\x02 \x01(\t\x12%\n\x04mode\x18\x03 \x01(\x0e\x32\x17.products.v1.StreamMode\"A\n\x16\x43lientResponseProducts\x12\'\n\x07product\x18\x01 \x01(\x0b\x32\x16.products.v1.ProdsPrep\"E\n\tProdsPrep\x12\r\n\x05title\x18\x01 \x01(\t\x12\x0b\n\x03url\x18\x02 \x01(\t\x12\x10\n\x08shortUrl\x18\x03 \x01(\t\x12\n\n\x02id\x18\x04 \x01(\t\"\x82\x01\n\x1a\x41\x64minClientRequestProducts\x12\'\n\x07product\x18\x01 \x01(\x0b\x32\x16.products.v1.ProdsPrep\x12\x0e\n\x06vendor\x18\x02 \x01(\t\x12\x13\n\x0bproductType\x18\x03 \x01(\t\x12\x16\n\x0eidempotencyKey\x18\x04 \x01(\t\"^\n\x0e\x43\x61talogProduct\x12\x0e\n\x06vendor\x18\x01 \x01(\t\x12\x13\n\x0bproductType\x18\x02 \x01(\t\x12\'\n\x07product\x18\x03 \x01(\x0b\x32\x16.products.v1.ProdsPrep\"\x1f\n\x11GetProductRequest\x12\n\n\x02id\x18\x01 \x01(\t\"\"\n\x14\x44\x65leteProductRequest\x12\n\n\x02id\x18\x01 \x01(\t\"\x17\n\x15\x44\x65leteProductResponse\"d\n\x06Vendor\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x13\n\x0b\x64isplayName\x18\x02 \x01(\t\x12\x10\n\x08homepage\x18\x03 \x01(\t\x12\x0f\n\x07\x65nabled\x18\x04 \x01(\x08\x12\x14\n\x0cproductTypes\x18\x05 \x03(\t\"j\n\x13UpdateVendorRequest\x12#\n\x06vendor\x18\x01 \x01(\x0b\x32\x13.products.v1.Vendor\x12.\n\nupdateMask\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.FieldMask\"\x14\n\x12ListVendorsRequest\";\n\x13ListVendorsResponse\x12$\n\x07vendors\x18\x01 \x03(\x0b\x32\x13.products.v1.Vendor\"2\n\x13\x44\x65leteVendorRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05\x66orce\x18\x02 \x01(\x08\"\x16\n\x14\x44\x65leteVendorResponse\"_\n\x13ListProductsRequest\x12\x0e\n\x06vendor\x18\x01 \x01(\t\x12\x13\n\x0bproductType\x18\x02 \x01(\t\x12\x10\n\x08pageSize\x18\x03 \x01(\x05\x12\x11\n\tpageToken\x18\x04 \x01(\t\"\\\n\x14ListProductsResponse\x12-\n\x08products\x18\x01 \x03(\x0b\x32\x1b.products.v1.CatalogProduct\x12\x15\n\rnextPageToken\x18\x02 \x01(\t\"G\n\x15SearchProductsRequest\x12\x0e\n\x06\x66ilter\x18\x01 \x01(\t\x12\x0f\n\x07orderBy\x18\x02 \x01(\t\x12\r\n\x05limit\x18\x03 \x01(\x05\"[\n\x16SearchProductsResponse\x12-\n\x08products\x18\x01 \x03(\x0b\x32\x1b.products.v1.CatalogProduct\x12\x12\n\ntotalCount\x18\x02 \x01(\x05\"E\n\x15\x46ullTextSearchRequest\x12\r\n\x05query\x18\x01 \x01(\t\x12\x0e\n\x06vendor\x18\x02 \x01(\t\x12\r\n\x05limit\x18\x03 \x01(\x05\"?\n\x16\x46ullTextSearchResponse\x12%\n\x04hits\x18\x01 \x03(\x0b\x32\x17.products.v1.ProductHit\"_\n\nProductHit\x12,\n\x07product\x18\x01 \x01(\x0b\x32\x1b.products.v1.CatalogProduct\x12\r\n\x05score\x18\x02 \x01(\x01\x12\x14\n\x0cmatchedTerms\x18\x03 \x03(\t\"\xbc\x01\n\x17GetShortUrlStatsRequest\x12\x0e\n\x06vendor\x18\x01 \x01(\t\x12\x11\n\tproductId\x18\x02 \x01(\t\x12(\n\x06\x62ucket\x18\x03 \x01(\x0e\x32\x18.products.v1.StatsBucket\x12)\n\x05since\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12)\n\x05until\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"|\n\x18GetShortUrlStatsResponse\x12\x30\n\x08products\x18\x01 \x03(\x0b\x32\x1e.products.v1.ProductClickStats\x12.\n\x07vendors\x18\x02 \x03(\x0b\x32\x1d.products.v1.VendorClickStats\"\x81\x01\n\x11ProductClickStats\x12,\n\x07product\x18\x01 \x01(\x0b\x32\x1b.products.v1.CatalogProduct\x12\x13\n\x0btotalClicks\x18\x02 \x01(\x03\x12)\n\x07\x62uckets\x18\x03 \x03(\x0b\x32\x18.products.v1.ClickBucket\"b\n\x10VendorClickStats\x12\x0e\n\x06vendor\x18\x01 \x01(\t\x12\x13\n\x0btotalClicks\x18\x02 \x01(\x03\x12)\n\x07\x62uckets\x18\x03 \x03(\x0b\x32\x18.products.v1.ClickBucket\"H\n\x0b\x43lickBucket\x12)\n\x05start\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0e\n\x06\x63licks\x18\x02 \x01(\x03\"`\n\x0cIngestResult\x12\r\n\x05index\x18\x01 \x01(\x03\x12\x0c\n\x02id\x18\x02 \x01(\tH\x00\x12)\n\x05\x65rror\x18\x03 \x01(\x0b\x32\x18.products.v1.IngestErrorH\x00\x42\x08\n\x06result\"b\n\x0bIngestError\x12\x0c\n\x04\x63ode\x18\x01 \x01(\x05\x12\x0f\n\x07message\x18\x02 \x01(\t\x12\x34\n\x0f\x66ieldViolations\x18\x03 \x03(\x0b\x32\x1b.products.v1.FieldViolation\"4\n\x0e\x46ieldViolation\x12\r\n\x05\x66ield\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\"T\n\x1dMergeDuplicateProductsRequest\x12\x0e\n\x06vendor\x18\x01 \x01(\t\x12\x13\n\x0bproductType\x18\x02 \x01(\t\x12\x0e\n\x06\x64ryRun\x18\x03 \x01(\x08\"c\n\x1eMergeDuplicateProductsResponse\x12+\n\x06groups\x18\x01 \x03(\x0b\x32\x1b.products.v1.DuplicateGroup\x12\x14\n\x0cremovedCount\x18\x02 \x01(\x05\"l\n\x0e\x44uplicateGroup\x12)\n\x04kept\x18\x01 \x01(\x0b\x32\x1b.products.v1.CatalogProduct\x12/\n\nduplicates\x18\x02 \x03(\x0b\x32\x1b.products.v1.CatalogProduct\"&\n\x14\x45xportCatalogRequest\x12\x0e\n\x06vendor\x18\x01 \x01(\t\"\xcb\x01\n\x0c\x45xportRecord\x12+\n\x06header\x18\x01 \x01(\x0b\x32\x19.products.v1.ExportHeaderH\x00\x12%\n\x06vendor\x18\x02 \x01(\x0b\x32\x13.products.v1.VendorH\x00\x12.\n\x07product\x18\x03 \x01(\x0b\x32\x1b.products.v1.CatalogProductH\x00\x12-\n\x07trailer\x18\x04 \x01(\x0b\x32\x1a.products.v1.ExportTrailerH\x00\x42\x08\n\x06record\"\x90\x01\n\x0c\x45xportHeader\x12\x15\n\rformatVersion\x18\x01 \x01(\x05\x12.\n\nexportedAt\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x13\n\x0bvendorCount\x18\x03 \x01(\x05\x12\x14\n\x0cproductCount\x18\x04 \x01(\x05\x12\x0e\n\x06vendor\x18\x05 \x01(\t\"L\n\rExportTrailer\x12\x13\n\x0bvendorCount\x18\x01 \x01(\x05\x12\x14\n\x0cproductCount\x18\x02 \x01(\x05\x12\x10\n\x08\x63hecksum\x18\x03 \x01(\t\"z\n\x15RestoreCatalogRequest\x12&\n\x04mode\x18\x01 \x01(\x0e\x32\x18.products.v1.RestoreMode\x12\x0e\n\x06\x64ryRun\x18\x02 \x01(\x08\x12)\n\x06record\x18\x03 \x01(\x0b\x32\x19.products.v1.ExportRecord\"\xb8\x01\n\x16RestoreCatalogResponse\x12\x0f\n\x07\x61pplied\x18\x01 \x01(\x08\x12\x14\n\x0cvendorsAdded\x18\x02 \x03(\t\x12\x16\n\x0evendorsChanged\x18\x03 \x03(\t\x12\x16\n\x0evendorsRemoved\x18\x04 \x03(\t\x12\x15\n\rproductsAdded\x18\x05 \x01(\x05\x12\x17\n\x0fproductsChanged\x18\x06 \x01(\x05\x12\x17\n\x0fproductsRemoved\x18\x07 \x01(\x05\"\x1d\n\x0cProductCount\x12\r\n\x05\x63ount\x18\x01 \x01(\x05\"\xb6\x03\n\x0b\x43hatMessage\x12\x18\n\x0emessageContent\x18\x01 \x01(\tH\x00\x12%\n\x04join\x18\x08 \x01(\x0b\x32\x15.products.v1.ChatJoinH\x00\x12\'\n\x05leave\x18\t \x01(\x0b\x32\x16.products.v1.ChatLeaveH\x00\x12)\n\x06typing\x18\n \x01(\x0b\x32\x17.products.v1.ChatTypingH\x00\x12\x33\n\x0breadReceipt\x18\x0b \x01(\x0b\x32\x1c.products.v1.ChatReadReceiptH\x00\x12\x33\n\nmoderation\x18\x0c \x01(\x0b\x32\x1d.products.v1.ModerationRecordH\x00\x12\x0e\n\x06sender\x18\x02 \x01(\t\x12\x0c\n\x04room\x18\x03 \x01(\t\x12-\n\ttimestamp\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\n\n\x02id\x18\x05 \x01(\t\x12\x15\n\rreplaySinceId\x18\x06 \x01(\t\x12/\n\x0breplaySince\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x07\n\x05\x65vent\"\n\n\x08\x43hatJoin\"\x0b\n\tChatLeave\"\x1c\n\nChatTyping\x12\x0e\n\x06typing\x18\x01 \x01(\x08\"$\n\x0f\x43hatReadReceipt\x12\x11\n\tmessageId\x18\x01 \x01(\t\"+\n\x1bListRoomParticipantsRequest\x12\x0c\n\x04room\x18\x01 \x01(\t\"L\n\x1cListRoomParticipantsResponse\x12,\n\x05rooms\x18\x01 \x03(\x0b\x32\x1d.products.v1.RoomParticipants\"T\n\x10RoomParticipants\x12\x0c\n\x04room\x18\x01 \x01(\t\x12\x32\n\x0cparticipants\x18\x02 \x03(\x0b\x32\x1c.products.v1.ChatParticipant\"b\n\x0f\x43hatParticipant\x12\x0c\n\x04name\x18\x01 \x01(\t\x12,\n\x08joinedAt\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x13\n\x0b\x63onnections\x18\x03 \x01(\x05\"m\n\x13ModerateChatRequest\x12\x0c\n\x04room\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x11\n\tmoderator\x18\x03 \x01(\t\x12\x0e\n\x06reason\x18\x04 \x01(\t\x12\x17\n\x0f\x64urationSeconds\x18\x05 \x01(\x03\"\xdc\x01\n\x10ModerationRecord\x12-\n\x06\x61\x63tion\x18\x01 \x01(\x0e\x32\x1d.products.v1.ModerationAction\x12\x0c\n\x04room\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x11\n\tmoderator\x18\x04 \x01(\t\x12\x0e\n\x06reason\x18\x05 \x01(\t\x12-\n\ttimestamp\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12+\n\x07\x65xpires\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"(\n\x18ListModerationLogRequest\x12\x0c\n\x04room\x18\x01 \x01(\t\"K\n\x19ListModerationLogResponse\x12.\n\x07records\x18\x01 \x03(\x0b\x32\x1d.products.v1.ModerationRecord*>\n\nStreamMode\x12\x16\n\x12STREAM_MODE_FOLLOW\x10\x00\x12\x18\n\x14STREAM_MODE_SNAPSHOT\x10\x01*:\n\x0bStatsBucket\x12\x15\n\x11STATS_BUCKET_HOUR\x10\x00\x12\x14\n\x10STATS_BUCKET_DAY\x10\x01*?\n\x0bRestoreMode\x12\x16\n\x12RESTORE_MODE_MERGE\x10\x00\x12\x18\n\x14RESTORE_MODE_REPLACE\x10\x01*\xa9\x01\n\x10ModerationAction\x12!\n\x1dMODERATION_ACTION_UNSPECIFIED\x10\x00\x12\x1a\n\x16MODERATION_ACTION_MUTE\x10\x01\x12\x1c\n\x18MODERATION_ACTION_UNMUTE\x10\x02\x12\x1a\n\x16MODERATION_ACTION_KICK\x10\x03\x12\x1c\n\x18MODERATION_ACTION_REJECT\x10\x04\x32\x93\x11\n\x0eProductService\x12X\n\x15GetVendorProductTypes\x12\x1e.products.v1.ClientRequestType\x1a\x1f.products.v1.ClientResponseType\x12^\n\x11GetVendorProducts\x12\".products.v1.ClientRequestProducts\x1a#.products.v1.ClientResponseProducts0\x01\x12Y\n\x11SetVendorProducts\x12\'.products.v1.AdminClientRequestProducts\x1a\x19.products.v1.ProductCount(\x01\x12I\n\x0f\x43hatVendorSales\x12\x18.products.v1.ChatMessage\x1a\x18.products.v1.ChatMessage(\x01\x30\x01\x12I\n\rCreateProduct\x12\x1b.products.v1.CatalogProduct\x1a\x1b.products.v1.CatalogProduct\x12I\n\nGetProduct\x12\x1e.products.v1.GetProductRequest\x1a\x1b.products.v1.CatalogProduct\x12I\n\rUpdateProduct\x12\x1b.products.v1.CatalogProduct\x1a\x1b.products.v1.CatalogProduct\x12V\n\rDeleteProduct\x12!.products.v1.DeleteProductRequest\x1a\".products.v1.DeleteProductResponse\x12P\n\x0bListVendors\x12\x1f.products.v1.ListVendorsRequest\x1a .products.v1.ListVendorsResponse\x12\x38\n\x0c\x43reateVendor\x12\x13.products.v1.Vendor\x1a\x13.products.v1.Vendor\x12\x45\n\x0cUpdateVendor\x12 .products.v1.UpdateVendorRequest\x1a\x13.products.v1.Vendor\x12S\n\x0c\x44\x65leteVendor\x12 .products.v1.DeleteVendorRequest\x1a!.products.v1.DeleteVendorResponse\x12S\n\x0cListProducts\x12 .products.v1.ListProductsRequest\x1a!.products.v1.ListProductsResponse\x12Y\n\x0eSearchProducts\x12\".products.v1.SearchProductsRequest\x1a#.products.v1.SearchProductsResponse\x12Y\n\x0e\x46ullTextSearch\x12\".products.v1.FullTextSearchRequest\x1a#.products.v1.FullTextSearchResponse\x12_\n\x10GetShortUrlStats\x12$.products.v1.GetShortUrlStatsRequest\x1a%.products.v1.GetShortUrlStatsResponse\x12X\n\x0eIngestProducts\x12\'.products.v1.AdminClientRequestProducts\x1a\x19.products.v1.IngestResult(\x01\x30\x01\x12q\n\x16MergeDuplicateProducts\x12*.products.v1.MergeDuplicateProductsRequest\x1a+.products.v1.MergeDuplicateProductsResponse\x12O\n\rExportCatalog\x12!.products.v1.ExportCatalogRequest\x1a\x19.products.v1.ExportRecord0\x01\x12[\n\x0eRestoreCatalog\x12\".products.v1.RestoreCatalogRequest\x1a#.products.v1.RestoreCatalogResponse(\x01\x12k\n\x14ListRoomParticipants\x12(.products.v1.ListRoomParticipantsRequest\x1a).products.v1.ListRoomParticipantsResponse\x12V\n\x13MuteChatParticipant\x12 .products.v1.ModerateChatRequest\x1a\x1d.products.v1.ModerationRecord\x12X\n\x15UnmuteChatParticipant\x12 .products.v1.ModerateChatRequest\x1a\x1d.products.v1.ModerationRecord\x12V\n\x13KickChatParticipant\x12 .products.v1.ModerateChatRequest\x1a\x1d.products.v1.ModerationRecord\x12\x62\n\x11ListModerationLog\x12%.products.v1.ListModerationLogRequest\x1a&.products.v1.ListModerationLogResponseb\x06proto3'
+  ,
+  dependencies=[google_dot_protobuf_dot_field__mask__pb2.DESCRIPTOR,google_dot_protobuf_dot_timestamp__pb2.DESCRIPTOR,])
+
+_STREAMMODE = _descriptor.EnumDescriptor(
+  name='StreamMode',
+  full_name='products.v1.StreamMode',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='STREAM_MODE_FOLLOW', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='STREAM_MODE_SNAPSHOT', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=5108,
+  serialized_end=5170,
+)
+_sym_db.RegisterEnumDescriptor(_STREAMMODE)
+
+StreamMode = enum_type_wrapper.EnumTypeWrapper(_STREAMMODE)
+_STATSBUCKET = _descriptor.EnumDescriptor(
+  name='StatsBucket',
+  full_name='products.v1.StatsBucket',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='STATS_BUCKET_HOUR', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='STATS_BUCKET_DAY', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=5172,
+  serialized_end=5230,
+)
+_sym_db.RegisterEnumDescriptor(_STATSBUCKET)
+
+StatsBucket = enum_type_wrapper.EnumTypeWrapper(_STATSBUCKET)
+_RESTOREMODE = _descriptor.EnumDescriptor(
+  name='RestoreMode',
+  full_name='products.v1.RestoreMode',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='RESTORE_MODE_MERGE', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='RESTORE_MODE_REPLACE', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=5232,
+  serialized_end=5295,
+)
+_sym_db.RegisterEnumDescriptor(_RESTOREMODE)
+
+RestoreMode = enum_type_wrapper.EnumTypeWrapper(_RESTOREMODE)
+_MODERATIONACTION = _descriptor.EnumDescriptor(
+  name='ModerationAction',
+  full_name='products.v1.ModerationAction',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='MODERATION_ACTION_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='MODERATION_ACTION_MUTE', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='MODERATION_ACTION_UNMUTE', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='MODERATION_ACTION_KICK', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='MODERATION_ACTION_REJECT', index=4, number=4,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=5298,
+  serialized_end=5467,
 )
+_sym_db.RegisterEnumDescriptor(_MODERATIONACTION)
 
+ModerationAction = enum_type_wrapper.EnumTypeWrapper(_MODERATIONACTION)
+STREAM_MODE_FOLLOW = 0
+STREAM_MODE_SNAPSHOT = 1
+STATS_BUCKET_HOUR = 0
+STATS_BUCKET_DAY = 1
+RESTORE_MODE_MERGE = 0
+RESTORE_MODE_REPLACE = 1
+MODERATION_ACTION_UNSPECIFIED = 0
+MODERATION_ACTION_MUTE = 1
+MODERATION_ACTION_UNMUTE = 2
+MODERATION_ACTION_KICK = 3
+MODERATION_ACTION_REJECT = 4
 
 
 
@@ -52,8 +186,8 @@ _CLIENTREQUESTTYPE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=31,
-  serialized_end=66,
+  serialized_start=98,
+  serialized_end=133,
 )
 
 
@@ -72,6 +206,59 @@ _CLIENTRESPONSETYPE = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='productTypes', full_name='products.v1.ClientResponseType.productTypes', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=135,
+  serialized_end=228,
+)
+
+
+_PRODUCTTYPEINFO = _descriptor.Descriptor(
+  name='ProductTypeInfo',
+  full_name='products.v1.ProductTypeInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='products.v1.ProductTypeInfo.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='displayName', full_name='products.v1.ProductTypeInfo.displayName', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='productCount', full_name='products.v1.ProductTypeInfo.productCount', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -84,8 +271,8 @@ _CLIENTRESPONSETYPE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=68,
-  serialized_end=109,
+  serialized_start=230,
+  serialized_end=304,
 )
 
 
@@ -111,6 +298,13 @@ _CLIENTREQUESTPRODUCTS = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='mode', full_name='products.v1.ClientRequestProducts.mode', index=2,
+      number=3, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -123,8 +317,8 @@ _CLIENTREQUESTPRODUCTS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=111,
-  serialized_end=171,
+  serialized_start=306,
+  serialized_end=405,
 )
 
 
@@ -155,8 +349,8 @@ _CLIENTRESPONSEPRODUCTS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=173,
-  serialized_end=238,
+  serialized_start=407,
+  serialized_end=472,
 )
 
 
@@ -189,6 +383,13 @@ _PRODSPREP = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='id', full_name='products.v1.ProdsPrep.id', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -201,8 +402,8 @@ _PRODSPREP = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=240,
-  serialized_end=297,
+  serialized_start=474,
+  serialized_end=543,
 )
 
 
@@ -235,6 +436,13 @@ _ADMINCLIENTREQUESTPRODUCTS = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='idempotencyKey', full_name='products.v1.AdminClientRequestProducts.idempotencyKey', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -247,23 +455,37 @@ _ADMINCLIENTREQUESTPRODUCTS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=299,
-  serialized_end=405,
+  serialized_start=546,
+  serialized_end=676,
 )
 
 
-_PRODUCTCOUNT = _descriptor.Descriptor(
-  name='ProductCount',
-  full_name='products.v1.ProductCount',
+_CATALOGPRODUCT = _descriptor.Descriptor(
+  name='CatalogProduct',
+  full_name='products.v1.CatalogProduct',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='count', full_name='products.v1.ProductCount.count', index=0,
-      number=1, type=5, cpp_type=1, label=1,
-      has_default_value=False, default_value=0,
+      name='vendor', full_name='products.v1.CatalogProduct.vendor', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='productType', full_name='products.v1.CatalogProduct.productType', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='product', full_name='products.v1.CatalogProduct.product', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
@@ -279,99 +501,2591 @@ _PRODUCTCOUNT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=407,
-  serialized_end=436,
+  serialized_start=678,
+  serialized_end=772,
 )
 
-_CLIENTRESPONSEPRODUCTS.fields_by_name['product'].message_type = _PRODSPREP
-_ADMINCLIENTREQUESTPRODUCTS.fields_by_name['product'].message_type = _PRODSPREP
-DESCRIPTOR.message_types_by_name['ClientRequestType'] = _CLIENTREQUESTTYPE
-DESCRIPTOR.message_types_by_name['ClientResponseType'] = _CLIENTRESPONSETYPE
-DESCRIPTOR.message_types_by_name['ClientRequestProducts'] = _CLIENTREQUESTPRODUCTS
-DESCRIPTOR.message_types_by_name['ClientResponseProducts'] = _CLIENTRESPONSEPRODUCTS
-DESCRIPTOR.message_types_by_name['ProdsPrep'] = _PRODSPREP
-DESCRIPTOR.message_types_by_name['AdminClientRequestProducts'] = _ADMINCLIENTREQUESTPRODUCTS
-DESCRIPTOR.message_types_by_name['ProductCount'] = _PRODUCTCOUNT
-_sym_db.RegisterFileDescriptor(DESCRIPTOR)
-
-ClientRequestType = _reflection.GeneratedProtocolMessageType('ClientRequestType', (_message.Message,), {
-  'DESCRIPTOR' : _CLIENTREQUESTTYPE,
-  '__module__' : 'products_pb2'
-  # @@protoc_insertion_point(class_scope:products.v1.ClientRequestType)
-  })
-_sym_db.RegisterMessage(ClientRequestType)
-
-ClientResponseType = _reflection.GeneratedProtocolMessageType('ClientResponseType', (_message.Message,), {
-  'DESCRIPTOR' : _CLIENTRESPONSETYPE,
-  '__module__' : 'products_pb2'
-  # @@protoc_insertion_point(class_scope:products.v1.ClientResponseType)
-  })
-_sym_db.RegisterMessage(ClientResponseType)
-
-ClientRequestProducts = _reflection.GeneratedProtocolMessageType('ClientRequestProducts', (_message.Message,), {
-  'DESCRIPTOR' : _CLIENTREQUESTPRODUCTS,
-  '__module__' : 'products_pb2'
-  # @@protoc_insertion_point(class_scope:products.v1.ClientRequestProducts)
-  })
-_sym_db.RegisterMessage(ClientRequestProducts)
 
-ClientResponseProducts = _reflection.GeneratedProtocolMessageType('ClientResponseProducts', (_message.Message,), {
-  'DESCRIPTOR' : _CLIENTRESPONSEPRODUCTS,
-  '__module__' : 'products_pb2'
-  # @@protoc_insertion_point(class_scope:products.v1.ClientResponseProducts)
-  })
-_sym_db.RegisterMessage(ClientResponseProducts)
+_GETPRODUCTREQUEST = _descriptor.Descriptor(
+  name='GetProductRequest',
+  full_name='products.v1.GetProductRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='products.v1.GetProductRequest.id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=774,
+  serialized_end=805,
+)
 
-ProdsPrep = _reflection.GeneratedProtocolMessageType('ProdsPrep', (_message.Message,), {
-  'DESCRIPTOR' : _PRODSPREP,
-  '__module__' : 'products_pb2'
-  # @@protoc_insertion_point(class_scope:products.v1.ProdsPrep)
-  })
-_sym_db.RegisterMessage(ProdsPrep)
 
-AdminClientRequestProducts = _reflection.GeneratedProtocolMessageType('AdminClientRequestProducts', (_message.Message,), {
-  'DESCRIPTOR' : _ADMINCLIENTREQUESTPRODUCTS,
-  '__module__' : 'products_pb2'
-  # @@protoc_insertion_point(class_scope:products.v1.AdminClientRequestProducts)
-  })
-_sym_db.RegisterMessage(AdminClientRequestProducts)
+_DELETEPRODUCTREQUEST = _descriptor.Descriptor(
+  name='DeleteProductRequest',
+  full_name='products.v1.DeleteProductRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='products.v1.DeleteProductRequest.id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=807,
+  serialized_end=841,
+)
 
-ProductCount = _reflection.GeneratedProtocolMessageType('ProductCount', (_message.Message,), {
-  'DESCRIPTOR' : _PRODUCTCOUNT,
-  '__module__' : 'products_pb2'
-  # @@protoc_insertion_point(class_scope:products.v1.ProductCount)
-  })
-_sym_db.RegisterMessage(ProductCount)
 
+_DELETEPRODUCTRESPONSE = _descriptor.Descriptor(
+  name='DeleteProductResponse',
+  full_name='products.v1.DeleteProductResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=843,
+  serialized_end=866,
+)
 
 
-_PRODUCTSERVICE = _descriptor.ServiceDescriptor(
-  name='ProductService',
-  full_name='products.v1.ProductService',
+_VENDOR = _descriptor.Descriptor(
+  name='Vendor',
+  full_name='products.v1.Vendor',
+  filename=None,
   file=DESCRIPTOR,
-  index=0,
-  serialized_options=None,
+  containing_type=None,
   create_key=_descriptor._internal_create_key,
-  serialized_start=439,
-  serialized_end=732,
-  methods=[
-  _descriptor.MethodDescriptor(
-    name='GetVendorProductTypes',
-    full_name='products.v1.ProductService.GetVendorProductTypes',
-    index=0,
-    containing_service=None,
-    input_type=_CLIENTREQUESTTYPE,
-    output_type=_CLIENTRESPONSETYPE,
-    serialized_options=None,
-    create_key=_descriptor._internal_create_key,
-  ),
-  _descriptor.MethodDescriptor(
-    name='GetVendorProducts',
-    full_name='products.v1.ProductService.GetVendorProducts',
-    index=1,
-    containing_service=None,
-    input_type=_CLIENTREQUESTPRODUCTS,
-    output_type=_CLIENTRESPONSEPRODUCTS,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='products.v1.Vendor.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='displayName', full_name='products.v1.Vendor.displayName', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='homepage', full_name='products.v1.Vendor.homepage', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='enabled', full_name='products.v1.Vendor.enabled', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='productTypes', full_name='products.v1.Vendor.productTypes', index=4,
+      number=5, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=868,
+  serialized_end=968,
+)
+
+
+_UPDATEVENDORREQUEST = _descriptor.Descriptor(
+  name='UpdateVendorRequest',
+  full_name='products.v1.UpdateVendorRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='vendor', full_name='products.v1.UpdateVendorRequest.vendor', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='updateMask', full_name='products.v1.UpdateVendorRequest.updateMask', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=970,
+  serialized_end=1076,
+)
+
+
+_LISTVENDORSREQUEST = _descriptor.Descriptor(
+  name='ListVendorsRequest',
+  full_name='products.v1.ListVendorsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1078,
+  serialized_end=1098,
+)
+
+
+_LISTVENDORSRESPONSE = _descriptor.Descriptor(
+  name='ListVendorsResponse',
+  full_name='products.v1.ListVendorsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='vendors', full_name='products.v1.ListVendorsResponse.vendors', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1100,
+  serialized_end=1159,
+)
+
+
+_DELETEVENDORREQUEST = _descriptor.Descriptor(
+  name='DeleteVendorRequest',
+  full_name='products.v1.DeleteVendorRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='products.v1.DeleteVendorRequest.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='force', full_name='products.v1.DeleteVendorRequest.force', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1161,
+  serialized_end=1211,
+)
+
+
+_DELETEVENDORRESPONSE = _descriptor.Descriptor(
+  name='DeleteVendorResponse',
+  full_name='products.v1.DeleteVendorResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1213,
+  serialized_end=1235,
+)
+
+
+_LISTPRODUCTSREQUEST = _descriptor.Descriptor(
+  name='ListProductsRequest',
+  full_name='products.v1.ListProductsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='vendor', full_name='products.v1.ListProductsRequest.vendor', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='productType', full_name='products.v1.ListProductsRequest.productType', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='pageSize', full_name='products.v1.ListProductsRequest.pageSize', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='pageToken', full_name='products.v1.ListProductsRequest.pageToken', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1237,
+  serialized_end=1332,
+)
+
+
+_LISTPRODUCTSRESPONSE = _descriptor.Descriptor(
+  name='ListProductsResponse',
+  full_name='products.v1.ListProductsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='products', full_name='products.v1.ListProductsResponse.products', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='nextPageToken', full_name='products.v1.ListProductsResponse.nextPageToken', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1334,
+  serialized_end=1426,
+)
+
+
+_SEARCHPRODUCTSREQUEST = _descriptor.Descriptor(
+  name='SearchProductsRequest',
+  full_name='products.v1.SearchProductsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='filter', full_name='products.v1.SearchProductsRequest.filter', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='orderBy', full_name='products.v1.SearchProductsRequest.orderBy', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='limit', full_name='products.v1.SearchProductsRequest.limit', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1428,
+  serialized_end=1499,
+)
+
+
+_SEARCHPRODUCTSRESPONSE = _descriptor.Descriptor(
+  name='SearchProductsResponse',
+  full_name='products.v1.SearchProductsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='products', full_name='products.v1.SearchProductsResponse.products', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='totalCount', full_name='products.v1.SearchProductsResponse.totalCount', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1501,
+  serialized_end=1592,
+)
+
+
+_FULLTEXTSEARCHREQUEST = _descriptor.Descriptor(
+  name='FullTextSearchRequest',
+  full_name='products.v1.FullTextSearchRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='query', full_name='products.v1.FullTextSearchRequest.query', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='vendor', full_name='products.v1.FullTextSearchRequest.vendor', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='limit', full_name='products.v1.FullTextSearchRequest.limit', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1594,
+  serialized_end=1663,
+)
+
+
+_FULLTEXTSEARCHRESPONSE = _descriptor.Descriptor(
+  name='FullTextSearchResponse',
+  full_name='products.v1.FullTextSearchResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='hits', full_name='products.v1.FullTextSearchResponse.hits', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1665,
+  serialized_end=1728,
+)
+
+
+_PRODUCTHIT = _descriptor.Descriptor(
+  name='ProductHit',
+  full_name='products.v1.ProductHit',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='product', full_name='products.v1.ProductHit.product', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='score', full_name='products.v1.ProductHit.score', index=1,
+      number=2, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='matchedTerms', full_name='products.v1.ProductHit.matchedTerms', index=2,
+      number=3, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1730,
+  serialized_end=1825,
+)
+
+
+_GETSHORTURLSTATSREQUEST = _descriptor.Descriptor(
+  name='GetShortUrlStatsRequest',
+  full_name='products.v1.GetShortUrlStatsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='vendor', full_name='products.v1.GetShortUrlStatsRequest.vendor', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='productId', full_name='products.v1.GetShortUrlStatsRequest.productId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='bucket', full_name='products.v1.GetShortUrlStatsRequest.bucket', index=2,
+      number=3, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='since', full_name='products.v1.GetShortUrlStatsRequest.since', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='until', full_name='products.v1.GetShortUrlStatsRequest.until', index=4,
+      number=5, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1828,
+  serialized_end=2016,
+)
+
+
+_GETSHORTURLSTATSRESPONSE = _descriptor.Descriptor(
+  name='GetShortUrlStatsResponse',
+  full_name='products.v1.GetShortUrlStatsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='products', full_name='products.v1.GetShortUrlStatsResponse.products', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='vendors', full_name='products.v1.GetShortUrlStatsResponse.vendors', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2018,
+  serialized_end=2142,
+)
+
+
+_PRODUCTCLICKSTATS = _descriptor.Descriptor(
+  name='ProductClickStats',
+  full_name='products.v1.ProductClickStats',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='product', full_name='products.v1.ProductClickStats.product', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='totalClicks', full_name='products.v1.ProductClickStats.totalClicks', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='buckets', full_name='products.v1.ProductClickStats.buckets', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2145,
+  serialized_end=2274,
+)
+
+
+_VENDORCLICKSTATS = _descriptor.Descriptor(
+  name='VendorClickStats',
+  full_name='products.v1.VendorClickStats',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='vendor', full_name='products.v1.VendorClickStats.vendor', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='totalClicks', full_name='products.v1.VendorClickStats.totalClicks', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='buckets', full_name='products.v1.VendorClickStats.buckets', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2276,
+  serialized_end=2374,
+)
+
+
+_CLICKBUCKET = _descriptor.Descriptor(
+  name='ClickBucket',
+  full_name='products.v1.ClickBucket',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='start', full_name='products.v1.ClickBucket.start', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='clicks', full_name='products.v1.ClickBucket.clicks', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2376,
+  serialized_end=2448,
+)
+
+
+_INGESTRESULT = _descriptor.Descriptor(
+  name='IngestResult',
+  full_name='products.v1.IngestResult',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='index', full_name='products.v1.IngestResult.index', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='id', full_name='products.v1.IngestResult.id', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='error', full_name='products.v1.IngestResult.error', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+    _descriptor.OneofDescriptor(
+      name='result', full_name='products.v1.IngestResult.result',
+      index=0, containing_type=None,
+      create_key=_descriptor._internal_create_key,
+    fields=[]),
+  ],
+  serialized_start=2450,
+  serialized_end=2546,
+)
+
+
+_INGESTERROR = _descriptor.Descriptor(
+  name='IngestError',
+  full_name='products.v1.IngestError',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='code', full_name='products.v1.IngestError.code', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='message', full_name='products.v1.IngestError.message', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='fieldViolations', full_name='products.v1.IngestError.fieldViolations', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2548,
+  serialized_end=2646,
+)
+
+
+_FIELDVIOLATION = _descriptor.Descriptor(
+  name='FieldViolation',
+  full_name='products.v1.FieldViolation',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='field', full_name='products.v1.FieldViolation.field', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='description', full_name='products.v1.FieldViolation.description', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2648,
+  serialized_end=2700,
+)
+
+
+_MERGEDUPLICATEPRODUCTSREQUEST = _descriptor.Descriptor(
+  name='MergeDuplicateProductsRequest',
+  full_name='products.v1.MergeDuplicateProductsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='vendor', full_name='products.v1.MergeDuplicateProductsRequest.vendor', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='productType', full_name='products.v1.MergeDuplicateProductsRequest.productType', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='dryRun', full_name='products.v1.MergeDuplicateProductsRequest.dryRun', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2702,
+  serialized_end=2786,
+)
+
+
+_MERGEDUPLICATEPRODUCTSRESPONSE = _descriptor.Descriptor(
+  name='MergeDuplicateProductsResponse',
+  full_name='products.v1.MergeDuplicateProductsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='groups', full_name='products.v1.MergeDuplicateProductsResponse.groups', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='removedCount', full_name='products.v1.MergeDuplicateProductsResponse.removedCount', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2788,
+  serialized_end=2887,
+)
+
+
+_DUPLICATEGROUP = _descriptor.Descriptor(
+  name='DuplicateGroup',
+  full_name='products.v1.DuplicateGroup',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='kept', full_name='products.v1.DuplicateGroup.kept', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='duplicates', full_name='products.v1.DuplicateGroup.duplicates', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2889,
+  serialized_end=2997,
+)
+
+
+_EXPORTCATALOGREQUEST = _descriptor.Descriptor(
+  name='ExportCatalogRequest',
+  full_name='products.v1.ExportCatalogRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='vendor', full_name='products.v1.ExportCatalogRequest.vendor', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2999,
+  serialized_end=3037,
+)
+
+
+_EXPORTRECORD = _descriptor.Descriptor(
+  name='ExportRecord',
+  full_name='products.v1.ExportRecord',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='header', full_name='products.v1.ExportRecord.header', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='vendor', full_name='products.v1.ExportRecord.vendor', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='product', full_name='products.v1.ExportRecord.product', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='trailer', full_name='products.v1.ExportRecord.trailer', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+    _descriptor.OneofDescriptor(
+      name='record', full_name='products.v1.ExportRecord.record',
+      index=0, containing_type=None,
+      create_key=_descriptor._internal_create_key,
+    fields=[]),
+  ],
+  serialized_start=3040,
+  serialized_end=3243,
+)
+
+
+_EXPORTHEADER = _descriptor.Descriptor(
+  name='ExportHeader',
+  full_name='products.v1.ExportHeader',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='formatVersion', full_name='products.v1.ExportHeader.formatVersion', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='exportedAt', full_name='products.v1.ExportHeader.exportedAt', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='vendorCount', full_name='products.v1.ExportHeader.vendorCount', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='productCount', full_name='products.v1.ExportHeader.productCount', index=3,
+      number=4, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='vendor', full_name='products.v1.ExportHeader.vendor', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3246,
+  serialized_end=3390,
+)
+
+
+_EXPORTTRAILER = _descriptor.Descriptor(
+  name='ExportTrailer',
+  full_name='products.v1.ExportTrailer',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='vendorCount', full_name='products.v1.ExportTrailer.vendorCount', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='productCount', full_name='products.v1.ExportTrailer.productCount', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='checksum', full_name='products.v1.ExportTrailer.checksum', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3392,
+  serialized_end=3468,
+)
+
+
+_RESTORECATALOGREQUEST = _descriptor.Descriptor(
+  name='RestoreCatalogRequest',
+  full_name='products.v1.RestoreCatalogRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='mode', full_name='products.v1.RestoreCatalogRequest.mode', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='dryRun', full_name='products.v1.RestoreCatalogRequest.dryRun', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='record', full_name='products.v1.RestoreCatalogRequest.record', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3470,
+  serialized_end=3592,
+)
+
+
+_RESTORECATALOGRESPONSE = _descriptor.Descriptor(
+  name='RestoreCatalogResponse',
+  full_name='products.v1.RestoreCatalogResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='applied', full_name='products.v1.RestoreCatalogResponse.applied', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='vendorsAdded', full_name='products.v1.RestoreCatalogResponse.vendorsAdded', index=1,
+      number=2, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='vendorsChanged', full_name='products.v1.RestoreCatalogResponse.vendorsChanged', index=2,
+      number=3, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='vendorsRemoved', full_name='products.v1.RestoreCatalogResponse.vendorsRemoved', index=3,
+      number=4, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='productsAdded', full_name='products.v1.RestoreCatalogResponse.productsAdded', index=4,
+      number=5, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='productsChanged', full_name='products.v1.RestoreCatalogResponse.productsChanged', index=5,
+      number=6, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='productsRemoved', full_name='products.v1.RestoreCatalogResponse.productsRemoved', index=6,
+      number=7, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3595,
+  serialized_end=3779,
+)
+
+
+_PRODUCTCOUNT = _descriptor.Descriptor(
+  name='ProductCount',
+  full_name='products.v1.ProductCount',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='count', full_name='products.v1.ProductCount.count', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3781,
+  serialized_end=3810,
+)
+
+
+_CHATMESSAGE = _descriptor.Descriptor(
+  name='ChatMessage',
+  full_name='products.v1.ChatMessage',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='messageContent', full_name='products.v1.ChatMessage.messageContent', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='join', full_name='products.v1.ChatMessage.join', index=1,
+      number=8, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='leave', full_name='products.v1.ChatMessage.leave', index=2,
+      number=9, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='typing', full_name='products.v1.ChatMessage.typing', index=3,
+      number=10, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='readReceipt', full_name='products.v1.ChatMessage.readReceipt', index=4,
+      number=11, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='moderation', full_name='products.v1.ChatMessage.moderation', index=5,
+      number=12, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='sender', full_name='products.v1.ChatMessage.sender', index=6,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='room', full_name='products.v1.ChatMessage.room', index=7,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='timestamp', full_name='products.v1.ChatMessage.timestamp', index=8,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='id', full_name='products.v1.ChatMessage.id', index=9,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='replaySinceId', full_name='products.v1.ChatMessage.replaySinceId', index=10,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='replaySince', full_name='products.v1.ChatMessage.replaySince', index=11,
+      number=7, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+    _descriptor.OneofDescriptor(
+      name='event', full_name='products.v1.ChatMessage.event',
+      index=0, containing_type=None,
+      create_key=_descriptor._internal_create_key,
+    fields=[]),
+  ],
+  serialized_start=3813,
+  serialized_end=4251,
+)
+
+
+_CHATJOIN = _descriptor.Descriptor(
+  name='ChatJoin',
+  full_name='products.v1.ChatJoin',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4253,
+  serialized_end=4263,
+)
+
+
+_CHATLEAVE = _descriptor.Descriptor(
+  name='ChatLeave',
+  full_name='products.v1.ChatLeave',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4265,
+  serialized_end=4276,
+)
+
+
+_CHATTYPING = _descriptor.Descriptor(
+  name='ChatTyping',
+  full_name='products.v1.ChatTyping',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='typing', full_name='products.v1.ChatTyping.typing', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4278,
+  serialized_end=4306,
+)
+
+
+_CHATREADRECEIPT = _descriptor.Descriptor(
+  name='ChatReadReceipt',
+  full_name='products.v1.ChatReadReceipt',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='messageId', full_name='products.v1.ChatReadReceipt.messageId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4308,
+  serialized_end=4344,
+)
+
+
+_LISTROOMPARTICIPANTSREQUEST = _descriptor.Descriptor(
+  name='ListRoomParticipantsRequest',
+  full_name='products.v1.ListRoomParticipantsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='room', full_name='products.v1.ListRoomParticipantsRequest.room', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4346,
+  serialized_end=4389,
+)
+
+
+_LISTROOMPARTICIPANTSRESPONSE = _descriptor.Descriptor(
+  name='ListRoomParticipantsResponse',
+  full_name='products.v1.ListRoomParticipantsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='rooms', full_name='products.v1.ListRoomParticipantsResponse.rooms', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4391,
+  serialized_end=4467,
+)
+
+
+_ROOMPARTICIPANTS = _descriptor.Descriptor(
+  name='RoomParticipants',
+  full_name='products.v1.RoomParticipants',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='room', full_name='products.v1.RoomParticipants.room', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='participants', full_name='products.v1.RoomParticipants.participants', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4469,
+  serialized_end=4553,
+)
+
+
+_CHATPARTICIPANT = _descriptor.Descriptor(
+  name='ChatParticipant',
+  full_name='products.v1.ChatParticipant',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='products.v1.ChatParticipant.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='joinedAt', full_name='products.v1.ChatParticipant.joinedAt', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='connections', full_name='products.v1.ChatParticipant.connections', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4555,
+  serialized_end=4653,
+)
+
+
+_MODERATECHATREQUEST = _descriptor.Descriptor(
+  name='ModerateChatRequest',
+  full_name='products.v1.ModerateChatRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='room', full_name='products.v1.ModerateChatRequest.room', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='products.v1.ModerateChatRequest.name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='moderator', full_name='products.v1.ModerateChatRequest.moderator', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='reason', full_name='products.v1.ModerateChatRequest.reason', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='durationSeconds', full_name='products.v1.ModerateChatRequest.durationSeconds', index=4,
+      number=5, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4655,
+  serialized_end=4764,
+)
+
+
+_MODERATIONRECORD = _descriptor.Descriptor(
+  name='ModerationRecord',
+  full_name='products.v1.ModerationRecord',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='action', full_name='products.v1.ModerationRecord.action', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='room', full_name='products.v1.ModerationRecord.room', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='products.v1.ModerationRecord.name', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='moderator', full_name='products.v1.ModerationRecord.moderator', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='reason', full_name='products.v1.ModerationRecord.reason', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='timestamp', full_name='products.v1.ModerationRecord.timestamp', index=5,
+      number=6, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='expires', full_name='products.v1.ModerationRecord.expires', index=6,
+      number=7, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4767,
+  serialized_end=4987,
+)
+
+
+_LISTMODERATIONLOGREQUEST = _descriptor.Descriptor(
+  name='ListModerationLogRequest',
+  full_name='products.v1.ListModerationLogRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='room', full_name='products.v1.ListModerationLogRequest.room', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4989,
+  serialized_end=5029,
+)
+
+
+_LISTMODERATIONLOGRESPONSE = _descriptor.Descriptor(
+  name='ListModerationLogResponse',
+  full_name='products.v1.ListModerationLogResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='records', full_name='products.v1.ListModerationLogResponse.records', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5031,
+  serialized_end=5106,
+)
+
+_CLIENTRESPONSETYPE.fields_by_name['productTypes'].message_type = _PRODUCTTYPEINFO
+_CLIENTREQUESTPRODUCTS.fields_by_name['mode'].enum_type = _STREAMMODE
+_CLIENTRESPONSEPRODUCTS.fields_by_name['product'].message_type = _PRODSPREP
+_ADMINCLIENTREQUESTPRODUCTS.fields_by_name['product'].message_type = _PRODSPREP
+_CATALOGPRODUCT.fields_by_name['product'].message_type = _PRODSPREP
+_UPDATEVENDORREQUEST.fields_by_name['vendor'].message_type = _VENDOR
+_UPDATEVENDORREQUEST.fields_by_name['updateMask'].message_type = google_dot_protobuf_dot_field__mask__pb2._FIELDMASK
+_LISTVENDORSRESPONSE.fields_by_name['vendors'].message_type = _VENDOR
+_LISTPRODUCTSRESPONSE.fields_by_name['products'].message_type = _CATALOGPRODUCT
+_SEARCHPRODUCTSRESPONSE.fields_by_name['products'].message_type = _CATALOGPRODUCT
+_FULLTEXTSEARCHRESPONSE.fields_by_name['hits'].message_type = _PRODUCTHIT
+_PRODUCTHIT.fields_by_name['product'].message_type = _CATALOGPRODUCT
+_GETSHORTURLSTATSREQUEST.fields_by_name['bucket'].enum_type = _STATSBUCKET
+_GETSHORTURLSTATSREQUEST.fields_by_name['since'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_GETSHORTURLSTATSREQUEST.fields_by_name['until'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_GETSHORTURLSTATSRESPONSE.fields_by_name['products'].message_type = _PRODUCTCLICKSTATS
+_GETSHORTURLSTATSRESPONSE.fields_by_name['vendors'].message_type = _VENDORCLICKSTATS
+_PRODUCTCLICKSTATS.fields_by_name['product'].message_type = _CATALOGPRODUCT
+_PRODUCTCLICKSTATS.fields_by_name['buckets'].message_type = _CLICKBUCKET
+_VENDORCLICKSTATS.fields_by_name['buckets'].message_type = _CLICKBUCKET
+_CLICKBUCKET.fields_by_name['start'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_INGESTRESULT.fields_by_name['error'].message_type = _INGESTERROR
+_INGESTRESULT.oneofs_by_name['result'].fields.append(
+  _INGESTRESULT.fields_by_name['id'])
+_INGESTRESULT.fields_by_name['id'].containing_oneof = _INGESTRESULT.oneofs_by_name['result']
+_INGESTRESULT.oneofs_by_name['result'].fields.append(
+  _INGESTRESULT.fields_by_name['error'])
+_INGESTRESULT.fields_by_name['error'].containing_oneof = _INGESTRESULT.oneofs_by_name['result']
+_INGESTERROR.fields_by_name['fieldViolations'].message_type = _FIELDVIOLATION
+_MERGEDUPLICATEPRODUCTSRESPONSE.fields_by_name['groups'].message_type = _DUPLICATEGROUP
+_DUPLICATEGROUP.fields_by_name['kept'].message_type = _CATALOGPRODUCT
+_DUPLICATEGROUP.fields_by_name['duplicates'].message_type = _CATALOGPRODUCT
+_EXPORTRECORD.fields_by_name['header'].message_type = _EXPORTHEADER
+_EXPORTRECORD.fields_by_name['vendor'].message_type = _VENDOR
+_EXPORTRECORD.fields_by_name['product'].message_type = _CATALOGPRODUCT
+_EXPORTRECORD.fields_by_name['trailer'].message_type = _EXPORTTRAILER
+_EXPORTRECORD.oneofs_by_name['record'].fields.append(
+  _EXPORTRECORD.fields_by_name['header'])
+_EXPORTRECORD.fields_by_name['header'].containing_oneof = _EXPORTRECORD.oneofs_by_name['record']
+_EXPORTRECORD.oneofs_by_name['record'].fields.append(
+  _EXPORTRECORD.fields_by_name['vendor'])
+_EXPORTRECORD.fields_by_name['vendor'].containing_oneof = _EXPORTRECORD.oneofs_by_name['record']
+_EXPORTRECORD.oneofs_by_name['record'].fields.append(
+  _EXPORTRECORD.fields_by_name['product'])
+_EXPORTRECORD.fields_by_name['product'].containing_oneof = _EXPORTRECORD.oneofs_by_name['record']
+_EXPORTRECORD.oneofs_by_name['record'].fields.append(
+  _EXPORTRECORD.fields_by_name['trailer'])
+_EXPORTRECORD.fields_by_name['trailer'].containing_oneof = _EXPORTRECORD.oneofs_by_name['record']
+_EXPORTHEADER.fields_by_name['exportedAt'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_RESTORECATALOGREQUEST.fields_by_name['mode'].enum_type = _RESTOREMODE
+_RESTORECATALOGREQUEST.fields_by_name['record'].message_type = _EXPORTRECORD
+_CHATMESSAGE.fields_by_name['join'].message_type = _CHATJOIN
+_CHATMESSAGE.fields_by_name['leave'].message_type = _CHATLEAVE
+_CHATMESSAGE.fields_by_name['typing'].message_type = _CHATTYPING
+_CHATMESSAGE.fields_by_name['readReceipt'].message_type = _CHATREADRECEIPT
+_CHATMESSAGE.fields_by_name['moderation'].message_type = _MODERATIONRECORD
+_CHATMESSAGE.fields_by_name['timestamp'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_CHATMESSAGE.fields_by_name['replaySince'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_CHATMESSAGE.oneofs_by_name['event'].fields.append(
+  _CHATMESSAGE.fields_by_name['messageContent'])
+_CHATMESSAGE.fields_by_name['messageContent'].containing_oneof = _CHATMESSAGE.oneofs_by_name['event']
+_CHATMESSAGE.oneofs_by_name['event'].fields.append(
+  _CHATMESSAGE.fields_by_name['join'])
+_CHATMESSAGE.fields_by_name['join'].containing_oneof = _CHATMESSAGE.oneofs_by_name['event']
+_CHATMESSAGE.oneofs_by_name['event'].fields.append(
+  _CHATMESSAGE.fields_by_name['leave'])
+_CHATMESSAGE.fields_by_name['leave'].containing_oneof = _CHATMESSAGE.oneofs_by_name['event']
+_CHATMESSAGE.oneofs_by_name['event'].fields.append(
+  _CHATMESSAGE.fields_by_name['typing'])
+_CHATMESSAGE.fields_by_name['typing'].containing_oneof = _CHATMESSAGE.oneofs_by_name['event']
+_CHATMESSAGE.oneofs_by_name['event'].fields.append(
+  _CHATMESSAGE.fields_by_name['readReceipt'])
+_CHATMESSAGE.fields_by_name['readReceipt'].containing_oneof = _CHATMESSAGE.oneofs_by_name['event']
+_CHATMESSAGE.oneofs_by_name['event'].fields.append(
+  _CHATMESSAGE.fields_by_name['moderation'])
+_CHATMESSAGE.fields_by_name['moderation'].containing_oneof = _CHATMESSAGE.oneofs_by_name['event']
+_LISTROOMPARTICIPANTSRESPONSE.fields_by_name['rooms'].message_type = _ROOMPARTICIPANTS
+_ROOMPARTICIPANTS.fields_by_name['participants'].message_type = _CHATPARTICIPANT
+_CHATPARTICIPANT.fields_by_name['joinedAt'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_MODERATIONRECORD.fields_by_name['action'].enum_type = _MODERATIONACTION
+_MODERATIONRECORD.fields_by_name['timestamp'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_MODERATIONRECORD.fields_by_name['expires'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_LISTMODERATIONLOGRESPONSE.fields_by_name['records'].message_type = _MODERATIONRECORD
+DESCRIPTOR.message_types_by_name['ClientRequestType'] = _CLIENTREQUESTTYPE
+DESCRIPTOR.message_types_by_name['ClientResponseType'] = _CLIENTRESPONSETYPE
+DESCRIPTOR.message_types_by_name['ProductTypeInfo'] = _PRODUCTTYPEINFO
+DESCRIPTOR.message_types_by_name['ClientRequestProducts'] = _CLIENTREQUESTPRODUCTS
+DESCRIPTOR.message_types_by_name['ClientResponseProducts'] = _CLIENTRESPONSEPRODUCTS
+DESCRIPTOR.message_types_by_name['ProdsPrep'] = _PRODSPREP
+DESCRIPTOR.message_types_by_name['AdminClientRequestProducts'] = _ADMINCLIENTREQUESTPRODUCTS
+DESCRIPTOR.message_types_by_name['CatalogProduct'] = _CATALOGPRODUCT
+DESCRIPTOR.message_types_by_name['GetProductRequest'] = _GETPRODUCTREQUEST
+DESCRIPTOR.message_types_by_name['DeleteProductRequest'] = _DELETEPRODUCTREQUEST
+DESCRIPTOR.message_types_by_name['DeleteProductResponse'] = _DELETEPRODUCTRESPONSE
+DESCRIPTOR.message_types_by_name['Vendor'] = _VENDOR
+DESCRIPTOR.message_types_by_name['UpdateVendorRequest'] = _UPDATEVENDORREQUEST
+DESCRIPTOR.message_types_by_name['ListVendorsRequest'] = _LISTVENDORSREQUEST
+DESCRIPTOR.message_types_by_name['ListVendorsResponse'] = _LISTVENDORSRESPONSE
+DESCRIPTOR.message_types_by_name['DeleteVendorRequest'] = _DELETEVENDORREQUEST
+DESCRIPTOR.message_types_by_name['DeleteVendorResponse'] = _DELETEVENDORRESPONSE
+DESCRIPTOR.message_types_by_name['ListProductsRequest'] = _LISTPRODUCTSREQUEST
+DESCRIPTOR.message_types_by_name['ListProductsResponse'] = _LISTPRODUCTSRESPONSE
+DESCRIPTOR.message_types_by_name['SearchProductsRequest'] = _SEARCHPRODUCTSREQUEST
+DESCRIPTOR.message_types_by_name['SearchProductsResponse'] = _SEARCHPRODUCTSRESPONSE
+DESCRIPTOR.message_types_by_name['FullTextSearchRequest'] = _FULLTEXTSEARCHREQUEST
+DESCRIPTOR.message_types_by_name['FullTextSearchResponse'] = _FULLTEXTSEARCHRESPONSE
+DESCRIPTOR.message_types_by_name['ProductHit'] = _PRODUCTHIT
+DESCRIPTOR.message_types_by_name['GetShortUrlStatsRequest'] = _GETSHORTURLSTATSREQUEST
+DESCRIPTOR.message_types_by_name['GetShortUrlStatsResponse'] = _GETSHORTURLSTATSRESPONSE
+DESCRIPTOR.message_types_by_name['ProductClickStats'] = _PRODUCTCLICKSTATS
+DESCRIPTOR.message_types_by_name['VendorClickStats'] = _VENDORCLICKSTATS
+DESCRIPTOR.message_types_by_name['ClickBucket'] = _CLICKBUCKET
+DESCRIPTOR.message_types_by_name['IngestResult'] = _INGESTRESULT
+DESCRIPTOR.message_types_by_name['IngestError'] = _INGESTERROR
+DESCRIPTOR.message_types_by_name['FieldViolation'] = _FIELDVIOLATION
+DESCRIPTOR.message_types_by_name['MergeDuplicateProductsRequest'] = _MERGEDUPLICATEPRODUCTSREQUEST
+DESCRIPTOR.message_types_by_name['MergeDuplicateProductsResponse'] = _MERGEDUPLICATEPRODUCTSRESPONSE
+DESCRIPTOR.message_types_by_name['DuplicateGroup'] = _DUPLICATEGROUP
+DESCRIPTOR.message_types_by_name['ExportCatalogRequest'] = _EXPORTCATALOGREQUEST
+DESCRIPTOR.message_types_by_name['ExportRecord'] = _EXPORTRECORD
+DESCRIPTOR.message_types_by_name['ExportHeader'] = _EXPORTHEADER
+DESCRIPTOR.message_types_by_name['ExportTrailer'] = _EXPORTTRAILER
+DESCRIPTOR.message_types_by_name['RestoreCatalogRequest'] = _RESTORECATALOGREQUEST
+DESCRIPTOR.message_types_by_name['RestoreCatalogResponse'] = _RESTORECATALOGRESPONSE
+DESCRIPTOR.message_types_by_name['ProductCount'] = _PRODUCTCOUNT
+DESCRIPTOR.message_types_by_name['ChatMessage'] = _CHATMESSAGE
+DESCRIPTOR.message_types_by_name['ChatJoin'] = _CHATJOIN
+DESCRIPTOR.message_types_by_name['ChatLeave'] = _CHATLEAVE
+DESCRIPTOR.message_types_by_name['ChatTyping'] = _CHATTYPING
+DESCRIPTOR.message_types_by_name['ChatReadReceipt'] = _CHATREADRECEIPT
+DESCRIPTOR.message_types_by_name['ListRoomParticipantsRequest'] = _LISTROOMPARTICIPANTSREQUEST
+DESCRIPTOR.message_types_by_name['ListRoomParticipantsResponse'] = _LISTROOMPARTICIPANTSRESPONSE
+DESCRIPTOR.message_types_by_name['RoomParticipants'] = _ROOMPARTICIPANTS
+DESCRIPTOR.message_types_by_name['ChatParticipant'] = _CHATPARTICIPANT
+DESCRIPTOR.message_types_by_name['ModerateChatRequest'] = _MODERATECHATREQUEST
+DESCRIPTOR.message_types_by_name['ModerationRecord'] = _MODERATIONRECORD
+DESCRIPTOR.message_types_by_name['ListModerationLogRequest'] = _LISTMODERATIONLOGREQUEST
+DESCRIPTOR.message_types_by_name['ListModerationLogResponse'] = _LISTMODERATIONLOGRESPONSE
+DESCRIPTOR.enum_types_by_name['StreamMode'] = _STREAMMODE
+DESCRIPTOR.enum_types_by_name['StatsBucket'] = _STATSBUCKET
+DESCRIPTOR.enum_types_by_name['RestoreMode'] = _RESTOREMODE
+DESCRIPTOR.enum_types_by_name['ModerationAction'] = _MODERATIONACTION
+_sym_db.RegisterFileDescriptor(DESCRIPTOR)
+
+ClientRequestType = _reflection.GeneratedProtocolMessageType('ClientRequestType', (_message.Message,), {
+  'DESCRIPTOR' : _CLIENTREQUESTTYPE,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ClientRequestType)
+  })
+_sym_db.RegisterMessage(ClientRequestType)
+
+ClientResponseType = _reflection.GeneratedProtocolMessageType('ClientResponseType', (_message.Message,), {
+  'DESCRIPTOR' : _CLIENTRESPONSETYPE,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ClientResponseType)
+  })
+_sym_db.RegisterMessage(ClientResponseType)
+
+ProductTypeInfo = _reflection.GeneratedProtocolMessageType('ProductTypeInfo', (_message.Message,), {
+  'DESCRIPTOR' : _PRODUCTTYPEINFO,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ProductTypeInfo)
+  })
+_sym_db.RegisterMessage(ProductTypeInfo)
+
+ClientRequestProducts = _reflection.GeneratedProtocolMessageType('ClientRequestProducts', (_message.Message,), {
+  'DESCRIPTOR' : _CLIENTREQUESTPRODUCTS,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ClientRequestProducts)
+  })
+_sym_db.RegisterMessage(ClientRequestProducts)
+
+ClientResponseProducts = _reflection.GeneratedProtocolMessageType('ClientResponseProducts', (_message.Message,), {
+  'DESCRIPTOR' : _CLIENTRESPONSEPRODUCTS,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ClientResponseProducts)
+  })
+_sym_db.RegisterMessage(ClientResponseProducts)
+
+ProdsPrep = _reflection.GeneratedProtocolMessageType('ProdsPrep', (_message.Message,), {
+  'DESCRIPTOR' : _PRODSPREP,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ProdsPrep)
+  })
+_sym_db.RegisterMessage(ProdsPrep)
+
+AdminClientRequestProducts = _reflection.GeneratedProtocolMessageType('AdminClientRequestProducts', (_message.Message,), {
+  'DESCRIPTOR' : _ADMINCLIENTREQUESTPRODUCTS,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.AdminClientRequestProducts)
+  })
+_sym_db.RegisterMessage(AdminClientRequestProducts)
+
+CatalogProduct = _reflection.GeneratedProtocolMessageType('CatalogProduct', (_message.Message,), {
+  'DESCRIPTOR' : _CATALOGPRODUCT,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.CatalogProduct)
+  })
+_sym_db.RegisterMessage(CatalogProduct)
+
+GetProductRequest = _reflection.GeneratedProtocolMessageType('GetProductRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GETPRODUCTREQUEST,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.GetProductRequest)
+  })
+_sym_db.RegisterMessage(GetProductRequest)
+
+DeleteProductRequest = _reflection.GeneratedProtocolMessageType('DeleteProductRequest', (_message.Message,), {
+  'DESCRIPTOR' : _DELETEPRODUCTREQUEST,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.DeleteProductRequest)
+  })
+_sym_db.RegisterMessage(DeleteProductRequest)
+
+DeleteProductResponse = _reflection.GeneratedProtocolMessageType('DeleteProductResponse', (_message.Message,), {
+  'DESCRIPTOR' : _DELETEPRODUCTRESPONSE,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.DeleteProductResponse)
+  })
+_sym_db.RegisterMessage(DeleteProductResponse)
+
+Vendor = _reflection.GeneratedProtocolMessageType('Vendor', (_message.Message,), {
+  'DESCRIPTOR' : _VENDOR,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.Vendor)
+  })
+_sym_db.RegisterMessage(Vendor)
+
+UpdateVendorRequest = _reflection.GeneratedProtocolMessageType('UpdateVendorRequest', (_message.Message,), {
+  'DESCRIPTOR' : _UPDATEVENDORREQUEST,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.UpdateVendorRequest)
+  })
+_sym_db.RegisterMessage(UpdateVendorRequest)
+
+ListVendorsRequest = _reflection.GeneratedProtocolMessageType('ListVendorsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _LISTVENDORSREQUEST,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ListVendorsRequest)
+  })
+_sym_db.RegisterMessage(ListVendorsRequest)
+
+ListVendorsResponse = _reflection.GeneratedProtocolMessageType('ListVendorsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _LISTVENDORSRESPONSE,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ListVendorsResponse)
+  })
+_sym_db.RegisterMessage(ListVendorsResponse)
+
+DeleteVendorRequest = _reflection.GeneratedProtocolMessageType('DeleteVendorRequest', (_message.Message,), {
+  'DESCRIPTOR' : _DELETEVENDORREQUEST,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.DeleteVendorRequest)
+  })
+_sym_db.RegisterMessage(DeleteVendorRequest)
+
+DeleteVendorResponse = _reflection.GeneratedProtocolMessageType('DeleteVendorResponse', (_message.Message,), {
+  'DESCRIPTOR' : _DELETEVENDORRESPONSE,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.DeleteVendorResponse)
+  })
+_sym_db.RegisterMessage(DeleteVendorResponse)
+
+ListProductsRequest = _reflection.GeneratedProtocolMessageType('ListProductsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _LISTPRODUCTSREQUEST,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ListProductsRequest)
+  })
+_sym_db.RegisterMessage(ListProductsRequest)
+
+ListProductsResponse = _reflection.GeneratedProtocolMessageType('ListProductsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _LISTPRODUCTSRESPONSE,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ListProductsResponse)
+  })
+_sym_db.RegisterMessage(ListProductsResponse)
+
+SearchProductsRequest = _reflection.GeneratedProtocolMessageType('SearchProductsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _SEARCHPRODUCTSREQUEST,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.SearchProductsRequest)
+  })
+_sym_db.RegisterMessage(SearchProductsRequest)
+
+SearchProductsResponse = _reflection.GeneratedProtocolMessageType('SearchProductsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _SEARCHPRODUCTSRESPONSE,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.SearchProductsResponse)
+  })
+_sym_db.RegisterMessage(SearchProductsResponse)
+
+FullTextSearchRequest = _reflection.GeneratedProtocolMessageType('FullTextSearchRequest', (_message.Message,), {
+  'DESCRIPTOR' : _FULLTEXTSEARCHREQUEST,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.FullTextSearchRequest)
+  })
+_sym_db.RegisterMessage(FullTextSearchRequest)
+
+FullTextSearchResponse = _reflection.GeneratedProtocolMessageType('FullTextSearchResponse', (_message.Message,), {
+  'DESCRIPTOR' : _FULLTEXTSEARCHRESPONSE,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.FullTextSearchResponse)
+  })
+_sym_db.RegisterMessage(FullTextSearchResponse)
+
+ProductHit = _reflection.GeneratedProtocolMessageType('ProductHit', (_message.Message,), {
+  'DESCRIPTOR' : _PRODUCTHIT,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ProductHit)
+  })
+_sym_db.RegisterMessage(ProductHit)
+
+GetShortUrlStatsRequest = _reflection.GeneratedProtocolMessageType('GetShortUrlStatsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GETSHORTURLSTATSREQUEST,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.GetShortUrlStatsRequest)
+  })
+_sym_db.RegisterMessage(GetShortUrlStatsRequest)
+
+GetShortUrlStatsResponse = _reflection.GeneratedProtocolMessageType('GetShortUrlStatsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _GETSHORTURLSTATSRESPONSE,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.GetShortUrlStatsResponse)
+  })
+_sym_db.RegisterMessage(GetShortUrlStatsResponse)
+
+ProductClickStats = _reflection.GeneratedProtocolMessageType('ProductClickStats', (_message.Message,), {
+  'DESCRIPTOR' : _PRODUCTCLICKSTATS,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ProductClickStats)
+  })
+_sym_db.RegisterMessage(ProductClickStats)
+
+VendorClickStats = _reflection.GeneratedProtocolMessageType('VendorClickStats', (_message.Message,), {
+  'DESCRIPTOR' : _VENDORCLICKSTATS,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.VendorClickStats)
+  })
+_sym_db.RegisterMessage(VendorClickStats)
+
+ClickBucket = _reflection.GeneratedProtocolMessageType('ClickBucket', (_message.Message,), {
+  'DESCRIPTOR' : _CLICKBUCKET,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ClickBucket)
+  })
+_sym_db.RegisterMessage(ClickBucket)
+
+IngestResult = _reflection.GeneratedProtocolMessageType('IngestResult', (_message.Message,), {
+  'DESCRIPTOR' : _INGESTRESULT,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.IngestResult)
+  })
+_sym_db.RegisterMessage(IngestResult)
+
+IngestError = _reflection.GeneratedProtocolMessageType('IngestError', (_message.Message,), {
+  'DESCRIPTOR' : _INGESTERROR,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.IngestError)
+  })
+_sym_db.RegisterMessage(IngestError)
+
+FieldViolation = _reflection.GeneratedProtocolMessageType('FieldViolation', (_message.Message,), {
+  'DESCRIPTOR' : _FIELDVIOLATION,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.FieldViolation)
+  })
+_sym_db.RegisterMessage(FieldViolation)
+
+MergeDuplicateProductsRequest = _reflection.GeneratedProtocolMessageType('MergeDuplicateProductsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _MERGEDUPLICATEPRODUCTSREQUEST,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.MergeDuplicateProductsRequest)
+  })
+_sym_db.RegisterMessage(MergeDuplicateProductsRequest)
+
+MergeDuplicateProductsResponse = _reflection.GeneratedProtocolMessageType('MergeDuplicateProductsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _MERGEDUPLICATEPRODUCTSRESPONSE,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.MergeDuplicateProductsResponse)
+  })
+_sym_db.RegisterMessage(MergeDuplicateProductsResponse)
+
+DuplicateGroup = _reflection.GeneratedProtocolMessageType('DuplicateGroup', (_message.Message,), {
+  'DESCRIPTOR' : _DUPLICATEGROUP,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.DuplicateGroup)
+  })
+_sym_db.RegisterMessage(DuplicateGroup)
+
+ExportCatalogRequest = _reflection.GeneratedProtocolMessageType('ExportCatalogRequest', (_message.Message,), {
+  'DESCRIPTOR' : _EXPORTCATALOGREQUEST,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ExportCatalogRequest)
+  })
+_sym_db.RegisterMessage(ExportCatalogRequest)
+
+ExportRecord = _reflection.GeneratedProtocolMessageType('ExportRecord', (_message.Message,), {
+  'DESCRIPTOR' : _EXPORTRECORD,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ExportRecord)
+  })
+_sym_db.RegisterMessage(ExportRecord)
+
+ExportHeader = _reflection.GeneratedProtocolMessageType('ExportHeader', (_message.Message,), {
+  'DESCRIPTOR' : _EXPORTHEADER,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ExportHeader)
+  })
+_sym_db.RegisterMessage(ExportHeader)
+
+ExportTrailer = _reflection.GeneratedProtocolMessageType('ExportTrailer', (_message.Message,), {
+  'DESCRIPTOR' : _EXPORTTRAILER,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ExportTrailer)
+  })
+_sym_db.RegisterMessage(ExportTrailer)
+
+RestoreCatalogRequest = _reflection.GeneratedProtocolMessageType('RestoreCatalogRequest', (_message.Message,), {
+  'DESCRIPTOR' : _RESTORECATALOGREQUEST,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.RestoreCatalogRequest)
+  })
+_sym_db.RegisterMessage(RestoreCatalogRequest)
+
+RestoreCatalogResponse = _reflection.GeneratedProtocolMessageType('RestoreCatalogResponse', (_message.Message,), {
+  'DESCRIPTOR' : _RESTORECATALOGRESPONSE,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.RestoreCatalogResponse)
+  })
+_sym_db.RegisterMessage(RestoreCatalogResponse)
+
+ProductCount = _reflection.GeneratedProtocolMessageType('ProductCount', (_message.Message,), {
+  'DESCRIPTOR' : _PRODUCTCOUNT,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ProductCount)
+  })
+_sym_db.RegisterMessage(ProductCount)
+
+ChatMessage = _reflection.GeneratedProtocolMessageType('ChatMessage', (_message.Message,), {
+  'DESCRIPTOR' : _CHATMESSAGE,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ChatMessage)
+  })
+_sym_db.RegisterMessage(ChatMessage)
+
+ChatJoin = _reflection.GeneratedProtocolMessageType('ChatJoin', (_message.Message,), {
+  'DESCRIPTOR' : _CHATJOIN,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ChatJoin)
+  })
+_sym_db.RegisterMessage(ChatJoin)
+
+ChatLeave = _reflection.GeneratedProtocolMessageType('ChatLeave', (_message.Message,), {
+  'DESCRIPTOR' : _CHATLEAVE,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ChatLeave)
+  })
+_sym_db.RegisterMessage(ChatLeave)
+
+ChatTyping = _reflection.GeneratedProtocolMessageType('ChatTyping', (_message.Message,), {
+  'DESCRIPTOR' : _CHATTYPING,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ChatTyping)
+  })
+_sym_db.RegisterMessage(ChatTyping)
+
+ChatReadReceipt = _reflection.GeneratedProtocolMessageType('ChatReadReceipt', (_message.Message,), {
+  'DESCRIPTOR' : _CHATREADRECEIPT,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ChatReadReceipt)
+  })
+_sym_db.RegisterMessage(ChatReadReceipt)
+
+ListRoomParticipantsRequest = _reflection.GeneratedProtocolMessageType('ListRoomParticipantsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _LISTROOMPARTICIPANTSREQUEST,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ListRoomParticipantsRequest)
+  })
+_sym_db.RegisterMessage(ListRoomParticipantsRequest)
+
+ListRoomParticipantsResponse = _reflection.GeneratedProtocolMessageType('ListRoomParticipantsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _LISTROOMPARTICIPANTSRESPONSE,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ListRoomParticipantsResponse)
+  })
+_sym_db.RegisterMessage(ListRoomParticipantsResponse)
+
+RoomParticipants = _reflection.GeneratedProtocolMessageType('RoomParticipants', (_message.Message,), {
+  'DESCRIPTOR' : _ROOMPARTICIPANTS,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.RoomParticipants)
+  })
+_sym_db.RegisterMessage(RoomParticipants)
+
+ChatParticipant = _reflection.GeneratedProtocolMessageType('ChatParticipant', (_message.Message,), {
+  'DESCRIPTOR' : _CHATPARTICIPANT,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ChatParticipant)
+  })
+_sym_db.RegisterMessage(ChatParticipant)
+
+ModerateChatRequest = _reflection.GeneratedProtocolMessageType('ModerateChatRequest', (_message.Message,), {
+  'DESCRIPTOR' : _MODERATECHATREQUEST,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ModerateChatRequest)
+  })
+_sym_db.RegisterMessage(ModerateChatRequest)
+
+ModerationRecord = _reflection.GeneratedProtocolMessageType('ModerationRecord', (_message.Message,), {
+  'DESCRIPTOR' : _MODERATIONRECORD,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ModerationRecord)
+  })
+_sym_db.RegisterMessage(ModerationRecord)
+
+ListModerationLogRequest = _reflection.GeneratedProtocolMessageType('ListModerationLogRequest', (_message.Message,), {
+  'DESCRIPTOR' : _LISTMODERATIONLOGREQUEST,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ListModerationLogRequest)
+  })
+_sym_db.RegisterMessage(ListModerationLogRequest)
+
+ListModerationLogResponse = _reflection.GeneratedProtocolMessageType('ListModerationLogResponse', (_message.Message,), {
+  'DESCRIPTOR' : _LISTMODERATIONLOGRESPONSE,
+  '__module__' : 'products_pb2'
+  # @@protoc_insertion_point(class_scope:products.v1.ListModerationLogResponse)
+  })
+_sym_db.RegisterMessage(ListModerationLogResponse)
+
+
+
+_PRODUCTSERVICE = _descriptor.ServiceDescriptor(
+  name='ProductService',
+  full_name='products.v1.ProductService',
+  file=DESCRIPTOR,
+  index=0,
+  serialized_options=None,
+  create_key=_descriptor._internal_create_key,
+  serialized_start=5470,
+  serialized_end=7665,
+  methods=[
+  _descriptor.MethodDescriptor(
+    name='GetVendorProductTypes',
+    full_name='products.v1.ProductService.GetVendorProductTypes',
+    index=0,
+    containing_service=None,
+    input_type=_CLIENTREQUESTTYPE,
+    output_type=_CLIENTRESPONSETYPE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetVendorProducts',
+    full_name='products.v1.ProductService.GetVendorProducts',
+    index=1,
+    containing_service=None,
+    input_type=_CLIENTREQUESTPRODUCTS,
+    output_type=_CLIENTRESPONSEPRODUCTS,
     serialized_options=None,
     create_key=_descriptor._internal_create_key,
   ),
@@ -385,6 +3099,226 @@ _PRODUCTSERVICE = _descriptor.ServiceDescriptor(
     serialized_options=None,
     create_key=_descriptor._internal_create_key,
   ),
+  _descriptor.MethodDescriptor(
+    name='ChatVendorSales',
+    full_name='products.v1.ProductService.ChatVendorSales',
+    index=3,
+    containing_service=None,
+    input_type=_CHATMESSAGE,
+    output_type=_CHATMESSAGE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='CreateProduct',
+    full_name='products.v1.ProductService.CreateProduct',
+    index=4,
+    containing_service=None,
+    input_type=_CATALOGPRODUCT,
+    output_type=_CATALOGPRODUCT,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetProduct',
+    full_name='products.v1.ProductService.GetProduct',
+    index=5,
+    containing_service=None,
+    input_type=_GETPRODUCTREQUEST,
+    output_type=_CATALOGPRODUCT,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='UpdateProduct',
+    full_name='products.v1.ProductService.UpdateProduct',
+    index=6,
+    containing_service=None,
+    input_type=_CATALOGPRODUCT,
+    output_type=_CATALOGPRODUCT,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='DeleteProduct',
+    full_name='products.v1.ProductService.DeleteProduct',
+    index=7,
+    containing_service=None,
+    input_type=_DELETEPRODUCTREQUEST,
+    output_type=_DELETEPRODUCTRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ListVendors',
+    full_name='products.v1.ProductService.ListVendors',
+    index=8,
+    containing_service=None,
+    input_type=_LISTVENDORSREQUEST,
+    output_type=_LISTVENDORSRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='CreateVendor',
+    full_name='products.v1.ProductService.CreateVendor',
+    index=9,
+    containing_service=None,
+    input_type=_VENDOR,
+    output_type=_VENDOR,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='UpdateVendor',
+    full_name='products.v1.ProductService.UpdateVendor',
+    index=10,
+    containing_service=None,
+    input_type=_UPDATEVENDORREQUEST,
+    output_type=_VENDOR,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='DeleteVendor',
+    full_name='products.v1.ProductService.DeleteVendor',
+    index=11,
+    containing_service=None,
+    input_type=_DELETEVENDORREQUEST,
+    output_type=_DELETEVENDORRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ListProducts',
+    full_name='products.v1.ProductService.ListProducts',
+    index=12,
+    containing_service=None,
+    input_type=_LISTPRODUCTSREQUEST,
+    output_type=_LISTPRODUCTSRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='SearchProducts',
+    full_name='products.v1.ProductService.SearchProducts',
+    index=13,
+    containing_service=None,
+    input_type=_SEARCHPRODUCTSREQUEST,
+    output_type=_SEARCHPRODUCTSRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='FullTextSearch',
+    full_name='products.v1.ProductService.FullTextSearch',
+    index=14,
+    containing_service=None,
+    input_type=_FULLTEXTSEARCHREQUEST,
+    output_type=_FULLTEXTSEARCHRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetShortUrlStats',
+    full_name='products.v1.ProductService.GetShortUrlStats',
+    index=15,
+    containing_service=None,
+    input_type=_GETSHORTURLSTATSREQUEST,
+    output_type=_GETSHORTURLSTATSRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='IngestProducts',
+    full_name='products.v1.ProductService.IngestProducts',
+    index=16,
+    containing_service=None,
+    input_type=_ADMINCLIENTREQUESTPRODUCTS,
+    output_type=_INGESTRESULT,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='MergeDuplicateProducts',
+    full_name='products.v1.ProductService.MergeDuplicateProducts',
+    index=17,
+    containing_service=None,
+    input_type=_MERGEDUPLICATEPRODUCTSREQUEST,
+    output_type=_MERGEDUPLICATEPRODUCTSRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ExportCatalog',
+    full_name='products.v1.ProductService.ExportCatalog',
+    index=18,
+    containing_service=None,
+    input_type=_EXPORTCATALOGREQUEST,
+    output_type=_EXPORTRECORD,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='RestoreCatalog',
+    full_name='products.v1.ProductService.RestoreCatalog',
+    index=19,
+    containing_service=None,
+    input_type=_RESTORECATALOGREQUEST,
+    output_type=_RESTORECATALOGRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ListRoomParticipants',
+    full_name='products.v1.ProductService.ListRoomParticipants',
+    index=20,
+    containing_service=None,
+    input_type=_LISTROOMPARTICIPANTSREQUEST,
+    output_type=_LISTROOMPARTICIPANTSRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='MuteChatParticipant',
+    full_name='products.v1.ProductService.MuteChatParticipant',
+    index=21,
+    containing_service=None,
+    input_type=_MODERATECHATREQUEST,
+    output_type=_MODERATIONRECORD,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='UnmuteChatParticipant',
+    full_name='products.v1.ProductService.UnmuteChatParticipant',
+    index=22,
+    containing_service=None,
+    input_type=_MODERATECHATREQUEST,
+    output_type=_MODERATIONRECORD,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='KickChatParticipant',
+    full_name='products.v1.ProductService.KickChatParticipant',
+    index=23,
+    containing_service=None,
+    input_type=_MODERATECHATREQUEST,
+    output_type=_MODERATIONRECORD,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ListModerationLog',
+    full_name='products.v1.ProductService.ListModerationLog',
+    index=24,
+    containing_service=None,
+    input_type=_LISTMODERATIONLOGREQUEST,
+    output_type=_LISTMODERATIONLOGRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
 ])
 _sym_db.RegisterServiceDescriptor(_PRODUCTSERVICE)
 
@@ -29,6 +29,116 @@ class ProductServiceStub(object):
                 request_serializer=products__pb2.AdminClientRequestProducts.SerializeToString,
                 response_deserializer=products__pb2.ProductCount.FromString,
                 )
+        self.ChatVendorSales = channel.stream_stream(
+                '/products.v1.ProductService/ChatVendorSales',
+                request_serializer=products__pb2.ChatMessage.SerializeToString,
+                response_deserializer=products__pb2.ChatMessage.FromString,
+                )
+        self.CreateProduct = channel.unary_unary(
+                '/products.v1.ProductService/CreateProduct',
+                request_serializer=products__pb2.CatalogProduct.SerializeToString,
+                response_deserializer=products__pb2.CatalogProduct.FromString,
+                )
+        self.GetProduct = channel.unary_unary(
+                '/products.v1.ProductService/GetProduct',
+                request_serializer=products__pb2.GetProductRequest.SerializeToString,
+                response_deserializer=products__pb2.CatalogProduct.FromString,
+                )
+        self.UpdateProduct = channel.unary_unary(
+                '/products.v1.ProductService/UpdateProduct',
+                request_serializer=products__pb2.CatalogProduct.SerializeToString,
+                response_deserializer=products__pb2.CatalogProduct.FromString,
+                )
+        self.DeleteProduct = channel.unary_unary(
+                '/products.v1.ProductService/DeleteProduct',
+                request_serializer=products__pb2.DeleteProductRequest.SerializeToString,
+                response_deserializer=products__pb2.DeleteProductResponse.FromString,
+                )
+        self.ListVendors = channel.unary_unary(
+                '/products.v1.ProductService/ListVendors',
+                request_serializer=products__pb2.ListVendorsRequest.SerializeToString,
+                response_deserializer=products__pb2.ListVendorsResponse.FromString,
+                )
+        self.CreateVendor = channel.unary_unary(
+                '/products.v1.ProductService/CreateVendor',
+                request_serializer=products__pb2.Vendor.SerializeToString,
+                response_deserializer=products__pb2.Vendor.FromString,
+                )
+        self.UpdateVendor = channel.unary_unary(
+                '/products.v1.ProductService/UpdateVendor',
+                request_serializer=products__pb2.UpdateVendorRequest.SerializeToString,
+                response_deserializer=products__pb2.Vendor.FromString,
+                )
+        self.DeleteVendor = channel.unary_unary(
+                '/products.v1.ProductService/DeleteVendor',
+                request_serializer=products__pb2.DeleteVendorRequest.SerializeToString,
+                response_deserializer=products__pb2.DeleteVendorResponse.FromString,
+                )
+        self.ListProducts = channel.unary_unary(
+                '/products.v1.ProductService/ListProducts',
+                request_serializer=products__pb2.ListProductsRequest.SerializeToString,
+                response_deserializer=products__pb2.ListProductsResponse.FromString,
+                )
+        self.SearchProducts = channel.unary_unary(
+                '/products.v1.ProductService/SearchProducts',
+                request_serializer=products__pb2.SearchProductsRequest.SerializeToString,
+                response_deserializer=products__pb2.SearchProductsResponse.FromString,
+                )
+        self.FullTextSearch = channel.unary_unary(
+                '/products.v1.ProductService/FullTextSearch',
+                request_serializer=products__pb2.FullTextSearchRequest.SerializeToString,
+                response_deserializer=products__pb2.FullTextSearchResponse.FromString,
+                )
+        self.GetShortUrlStats = channel.unary_unary(
+                '/products.v1.ProductService/GetShortUrlStats',
+                request_serializer=products__pb2.GetShortUrlStatsRequest.SerializeToString,
+                response_deserializer=products__pb2.GetShortUrlStatsResponse.FromString,
+                )
+        self.IngestProducts = channel.stream_stream(
+                '/products.v1.ProductService/IngestProducts',
+                request_serializer=products__pb2.AdminClientRequestProducts.SerializeToString,
+                response_deserializer=products__pb2.IngestResult.FromString,
+                )
+        self.MergeDuplicateProducts = channel.unary_unary(
+                '/products.v1.ProductService/MergeDuplicateProducts',
+                request_serializer=products__pb2.MergeDuplicateProductsRequest.SerializeToString,
+                response_deserializer=products__pb2.MergeDuplicateProductsResponse.FromString,
+                )
+        self.ExportCatalog = channel.unary_stream(
+                '/products.v1.ProductService/ExportCatalog',
+                request_serializer=products__pb2.ExportCatalogRequest.SerializeToString,
+                response_deserializer=products__pb2.ExportRecord.FromString,
+                )
+        self.RestoreCatalog = channel.stream_unary(
+                '/products.v1.ProductService/RestoreCatalog',
+                request_serializer=products__pb2.RestoreCatalogRequest.SerializeToString,
+                response_deserializer=products__pb2.RestoreCatalogResponse.FromString,
+                )
+        self.ListRoomParticipants = channel.unary_unary(
+                '/products.v1.ProductService/ListRoomParticipants',
+                request_serializer=products__pb2.ListRoomParticipantsRequest.SerializeToString,
+                response_deserializer=products__pb2.ListRoomParticipantsResponse.FromString,
+                )
+        self.MuteChatParticipant = channel.unary_unary(
+                '/products.v1.ProductService/MuteChatParticipant',
+                request_serializer=products__pb2.ModerateChatRequest.SerializeToString,
+                response_deserializer=products__pb2.ModerationRecord.FromString,
+                )
+        self.UnmuteChatParticipant = channel.unary_unary(
+                '/products.v1.ProductService/UnmuteChatParticipant',
+                request_serializer=products__pb2.ModerateChatRequest.SerializeToString,
+                response_deserializer=products__pb2.ModerationRecord.FromString,
+                )
+        self.KickChatParticipant = channel.unary_unary(
+                '/products.v1.ProductService/KickChatParticipant',
+                request_serializer=products__pb2.ModerateChatRequest.SerializeToString,
+                response_deserializer=products__pb2.ModerationRecord.FromString,
+                )
+        self.ListModerationLog = channel.unary_unary(
+                '/products.v1.ProductService/ListModerationLog',
+                request_serializer=products__pb2.ListModerationLogRequest.SerializeToString,
+                response_deserializer=products__pb2.ListModerationLogResponse.FromString,
+                )
 
 
 class ProductServiceServicer(object):
@@ -52,6 +162,138 @@ class ProductServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def ChatVendorSales(self, request_iterator, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CreateProduct(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetProduct(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UpdateProduct(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteProduct(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListVendors(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CreateVendor(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UpdateVendor(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteVendor(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListProducts(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SearchProducts(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def FullTextSearch(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetShortUrlStats(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def IngestProducts(self, request_iterator, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def MergeDuplicateProducts(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ExportCatalog(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RestoreCatalog(self, request_iterator, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListRoomParticipants(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def MuteChatParticipant(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UnmuteChatParticipant(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def KickChatParticipant(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListModerationLog(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_ProductServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -70,6 +312,116 @@ def add_ProductServiceServicer_to_server(servicer, server):
                     request_deserializer=products__pb2.AdminClientRequestProducts.FromString,
                     response_serializer=products__pb2.ProductCount.SerializeToString,
             ),
+            'ChatVendorSales': grpc.stream_stream_rpc_method_handler(
+                    servicer.ChatVendorSales,
+                    request_deserializer=products__pb2.ChatMessage.FromString,
+                    response_serializer=products__pb2.ChatMessage.SerializeToString,
+            ),
+            'CreateProduct': grpc.unary_unary_rpc_method_handler(
+                    servicer.CreateProduct,
+                    request_deserializer=products__pb2.CatalogProduct.FromString,
+                    response_serializer=products__pb2.CatalogProduct.SerializeToString,
+            ),
+            'GetProduct': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetProduct,
+                    request_deserializer=products__pb2.GetProductRequest.FromString,
+                    response_serializer=products__pb2.CatalogProduct.SerializeToString,
+            ),
+            'UpdateProduct': grpc.unary_unary_rpc_method_handler(
+                    servicer.UpdateProduct,
+                    request_deserializer=products__pb2.CatalogProduct.FromString,
+                    response_serializer=products__pb2.CatalogProduct.SerializeToString,
+            ),
+            'DeleteProduct': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteProduct,
+                    request_deserializer=products__pb2.DeleteProductRequest.FromString,
+                    response_serializer=products__pb2.DeleteProductResponse.SerializeToString,
+            ),
+            'ListVendors': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListVendors,
+                    request_deserializer=products__pb2.ListVendorsRequest.FromString,
+                    response_serializer=products__pb2.ListVendorsResponse.SerializeToString,
+            ),
+            'CreateVendor': grpc.unary_unary_rpc_method_handler(
+                    servicer.CreateVendor,
+                    request_deserializer=products__pb2.Vendor.FromString,
+                    response_serializer=products__pb2.Vendor.SerializeToString,
+            ),
+            'UpdateVendor': grpc.unary_unary_rpc_method_handler(
+                    servicer.UpdateVendor,
+                    request_deserializer=products__pb2.UpdateVendorRequest.FromString,
+                    response_serializer=products__pb2.Vendor.SerializeToString,
+            ),
+            'DeleteVendor': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteVendor,
+                    request_deserializer=products__pb2.DeleteVendorRequest.FromString,
+                    response_serializer=products__pb2.DeleteVendorResponse.SerializeToString,
+            ),
+            'ListProducts': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListProducts,
+                    request_deserializer=products__pb2.ListProductsRequest.FromString,
+                    response_serializer=products__pb2.ListProductsResponse.SerializeToString,
+            ),
+            'SearchProducts': grpc.unary_unary_rpc_method_handler(
+                    servicer.SearchProducts,
+                    request_deserializer=products__pb2.SearchProductsRequest.FromString,
+                    response_serializer=products__pb2.SearchProductsResponse.SerializeToString,
+            ),
+            'FullTextSearch': grpc.unary_unary_rpc_method_handler(
+                    servicer.FullTextSearch,
+                    request_deserializer=products__pb2.FullTextSearchRequest.FromString,
+                    response_serializer=products__pb2.FullTextSearchResponse.SerializeToString,
+            ),
+            'GetShortUrlStats': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetShortUrlStats,
+                    request_deserializer=products__pb2.GetShortUrlStatsRequest.FromString,
+                    response_serializer=products__pb2.GetShortUrlStatsResponse.SerializeToString,
+            ),
+            'IngestProducts': grpc.stream_stream_rpc_method_handler(
+                    servicer.IngestProducts,
+                    request_deserializer=products__pb2.AdminClientRequestProducts.FromString,
+                    response_serializer=products__pb2.IngestResult.SerializeToString,
+            ),
+            'MergeDuplicateProducts': grpc.unary_unary_rpc_method_handler(
+                    servicer.MergeDuplicateProducts,
+                    request_deserializer=products__pb2.MergeDuplicateProductsRequest.FromString,
+                    response_serializer=products__pb2.MergeDuplicateProductsResponse.SerializeToString,
+            ),
+            'ExportCatalog': grpc.unary_stream_rpc_method_handler(
+                    servicer.ExportCatalog,
+                    request_deserializer=products__pb2.ExportCatalogRequest.FromString,
+                    response_serializer=products__pb2.ExportRecord.SerializeToString,
+            ),
+            'RestoreCatalog': grpc.stream_unary_rpc_method_handler(
+                    servicer.RestoreCatalog,
+                    request_deserializer=products__pb2.RestoreCatalogRequest.FromString,
+                    response_serializer=products__pb2.RestoreCatalogResponse.SerializeToString,
+            ),
+            'ListRoomParticipants': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListRoomParticipants,
+                    request_deserializer=products__pb2.ListRoomParticipantsRequest.FromString,
+                    response_serializer=products__pb2.ListRoomParticipantsResponse.SerializeToString,
+            ),
+            'MuteChatParticipant': grpc.unary_unary_rpc_method_handler(
+                    servicer.MuteChatParticipant,
+                    request_deserializer=products__pb2.ModerateChatRequest.FromString,
+                    response_serializer=products__pb2.ModerationRecord.SerializeToString,
+            ),
+            'UnmuteChatParticipant': grpc.unary_unary_rpc_method_handler(
+                    servicer.UnmuteChatParticipant,
+                    request_deserializer=products__pb2.ModerateChatRequest.FromString,
+                    response_serializer=products__pb2.ModerationRecord.SerializeToString,
+            ),
+            'KickChatParticipant': grpc.unary_unary_rpc_method_handler(
+                    servicer.KickChatParticipant,
+                    request_deserializer=products__pb2.ModerateChatRequest.FromString,
+                    response_serializer=products__pb2.ModerationRecord.SerializeToString,
+            ),
+            'ListModerationLog': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListModerationLog,
+                    request_deserializer=products__pb2.ListModerationLogRequest.FromString,
+                    response_serializer=products__pb2.ListModerationLogResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'products.v1.ProductService', rpc_method_handlers)
@@ -130,3 +482,377 @@ class ProductService(object):
             products__pb2.ProductCount.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ChatVendorSales(request_iterator,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.stream_stream(request_iterator, target, '/products.v1.ProductService/ChatVendorSales',
+            products__pb2.ChatMessage.SerializeToString,
+            products__pb2.ChatMessage.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def CreateProduct(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/products.v1.ProductService/CreateProduct',
+            products__pb2.CatalogProduct.SerializeToString,
+            products__pb2.CatalogProduct.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetProduct(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/products.v1.ProductService/GetProduct',
+            products__pb2.GetProductRequest.SerializeToString,
+            products__pb2.CatalogProduct.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def UpdateProduct(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/products.v1.ProductService/UpdateProduct',
+            products__pb2.CatalogProduct.SerializeToString,
+            products__pb2.CatalogProduct.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DeleteProduct(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/products.v1.ProductService/DeleteProduct',
+            products__pb2.DeleteProductRequest.SerializeToString,
+            products__pb2.DeleteProductResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListVendors(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/products.v1.ProductService/ListVendors',
+            products__pb2.ListVendorsRequest.SerializeToString,
+            products__pb2.ListVendorsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def CreateVendor(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/products.v1.ProductService/CreateVendor',
+            products__pb2.Vendor.SerializeToString,
+            products__pb2.Vendor.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def UpdateVendor(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/products.v1.ProductService/UpdateVendor',
+            products__pb2.UpdateVendorRequest.SerializeToString,
+            products__pb2.Vendor.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DeleteVendor(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/products.v1.ProductService/DeleteVendor',
+            products__pb2.DeleteVendorRequest.SerializeToString,
+            products__pb2.DeleteVendorResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListProducts(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/products.v1.ProductService/ListProducts',
+            products__pb2.ListProductsRequest.SerializeToString,
+            products__pb2.ListProductsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def SearchProducts(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/products.v1.ProductService/SearchProducts',
+            products__pb2.SearchProductsRequest.SerializeToString,
+            products__pb2.SearchProductsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def FullTextSearch(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/products.v1.ProductService/FullTextSearch',
+            products__pb2.FullTextSearchRequest.SerializeToString,
+            products__pb2.FullTextSearchResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetShortUrlStats(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/products.v1.ProductService/GetShortUrlStats',
+            products__pb2.GetShortUrlStatsRequest.SerializeToString,
+            products__pb2.GetShortUrlStatsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def IngestProducts(request_iterator,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.stream_stream(request_iterator, target, '/products.v1.ProductService/IngestProducts',
+            products__pb2.AdminClientRequestProducts.SerializeToString,
+            products__pb2.IngestResult.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def MergeDuplicateProducts(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/products.v1.ProductService/MergeDuplicateProducts',
+            products__pb2.MergeDuplicateProductsRequest.SerializeToString,
+            products__pb2.MergeDuplicateProductsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ExportCatalog(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/products.v1.ProductService/ExportCatalog',
+            products__pb2.ExportCatalogRequest.SerializeToString,
+            products__pb2.ExportRecord.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def RestoreCatalog(request_iterator,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.stream_unary(request_iterator, target, '/products.v1.ProductService/RestoreCatalog',
+            products__pb2.RestoreCatalogRequest.SerializeToString,
+            products__pb2.RestoreCatalogResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListRoomParticipants(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/products.v1.ProductService/ListRoomParticipants',
+            products__pb2.ListRoomParticipantsRequest.SerializeToString,
+            products__pb2.ListRoomParticipantsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def MuteChatParticipant(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/products.v1.ProductService/MuteChatParticipant',
+            products__pb2.ModerateChatRequest.SerializeToString,
+            products__pb2.ModerationRecord.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def UnmuteChatParticipant(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/products.v1.ProductService/UnmuteChatParticipant',
+            products__pb2.ModerateChatRequest.SerializeToString,
+            products__pb2.ModerationRecord.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def KickChatParticipant(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/products.v1.ProductService/KickChatParticipant',
+            products__pb2.ModerateChatRequest.SerializeToString,
+            products__pb2.ModerationRecord.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListModerationLog(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/products.v1.ProductService/ListModerationLog',
+            products__pb2.ListModerationLogRequest.SerializeToString,
+            products__pb2.ListModerationLogResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
@@ -1,11 +1,15 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"io"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 
 	api "github.com/bharat-rajani/grpc-products-demo/api"
@@ -16,10 +20,66 @@ import (
 
 var (
 	// grpcPort = os.Getenv("GRPC_PORT")
-	grpcPort = "8080"
+	grpcPort     = "8080"
+	dataDir      = flag.String("data-dir", "", "Directory persisting the catalog, keeps it in memory only if empty")
+	httpPort     = flag.String("http-port", "8081", "Port serving the short URL redirects")
+	shortBaseURL = flag.String("short-base-url", "http://localhost:8081", "Base URL of the short URLs handed out for products")
+	duplicates   = flag.String("duplicates", "reject", "What to do with new products whose title is already listed: reject, upsert or keep")
+	chatRate     = flag.Float64("chat-rate", 1, "Chat messages per second a participant may post over time, unlimited if 0")
+	chatBurst    = flag.Int("chat-burst", 5, "Chat messages a participant may post at once")
+	chatMaxLen   = flag.Int("chat-max-length", 1000, "Maximum number of characters of a chat message, unlimited if 0")
+	blockedWords = flag.String("chat-blocked-words", "", "Comma-separated words chat messages may not contain")
 )
 
 func main() {
+	flag.Parse()
+
+	duplicatePolicy, err := api.ParseDuplicatePolicy(*duplicates)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var productStore api.ProductStore
+	if *dataDir == "" {
+		productStore = api.NewMemoryStore(api.DefaultCatalog())
+	} else {
+		fileStore, err := api.OpenFileStore(*dataDir, api.DefaultCatalog())
+		if err != nil {
+			log.Fatalf("could not open catalog in %s: %v", *dataDir, err)
+		}
+		productStore = fileStore
+	}
+
+	chatRules := api.ChatRules{
+		MessageRate:      *chatRate,
+		MessageBurst:     *chatBurst,
+		MaxMessageLength: *chatMaxLen,
+	}
+	if *blockedWords != "" {
+		chatRules.BlockedWords = strings.Split(*blockedWords, ",")
+	}
+
+	var links *api.Shortener
+	chatHistory := api.NewChatHistory()
+	chatModerator := api.NewChatModerator(chatRules)
+	if *dataDir == "" {
+		links = api.NewShortener(*shortBaseURL)
+	} else {
+		var err error
+		links, err = api.OpenShortener(*shortBaseURL, *dataDir)
+		if err != nil {
+			log.Fatalf("could not open short links in %s: %v", *dataDir, err)
+		}
+		chatHistory, err = api.OpenChatHistory(*dataDir)
+		if err != nil {
+			log.Fatalf("could not open chat history in %s: %v", *dataDir, err)
+		}
+		chatModerator, err = api.OpenChatModerator(chatRules, *dataDir)
+		if err != nil {
+			log.Fatalf("could not open moderation log in %s: %v", *dataDir, err)
+		}
+	}
+
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", grpcPort))
 	if err != nil {
 		panic(err)
@@ -32,13 +92,7 @@ func main() {
 		grpcServer := grpc.NewServer()
 
 		// create product server struct
-		var vendorServices = map[string][]string{
-			"google": {"compute", "storage"},
-			"aws":    {"compute", "storage"},
-			"oracle": {"compute", "storage"},
-		}
-
-		productServer := api.NewProductServer(vendorServices)
+		productServer := api.NewProductServer(productStore, links, api.WithDuplicatePolicy(duplicatePolicy), api.WithChatHistory(chatHistory), api.WithChatModerator(chatModerator))
 
 		pb.RegisterProductServiceServer(grpcServer, productServer)
 		reflection.Register(grpcServer)
@@ -48,6 +102,11 @@ func main() {
 		errs <- err
 	}()
 
+	go func() {
+		log.Printf("Starting short URL redirects on HTTP_PORT=[%s]", *httpPort)
+		errs <- http.ListenAndServe(":"+*httpPort, links)
+	}()
+
 	// Catch shutdown
 	go func() {
 		sig := make(chan os.Signal, 1)
@@ -56,5 +115,20 @@ func main() {
 		errs <- fmt.Errorf("caught signal %v", s)
 	}()
 
-	log.Fatal(<-errs)
+	err = <-errs
+	if closer, ok := productStore.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			log.Printf("could not close catalog: %v", err)
+		}
+	}
+	if err := links.Close(); err != nil {
+		log.Printf("could not close short links: %v", err)
+	}
+	if err := chatHistory.Close(); err != nil {
+		log.Printf("could not close chat history: %v", err)
+	}
+	if err := chatModerator.Close(); err != nil {
+		log.Printf("could not close moderation log: %v", err)
+	}
+	log.Fatal(err)
 }
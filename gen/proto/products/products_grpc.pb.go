@@ -21,6 +21,27 @@ type ProductServiceClient interface {
 	GetVendorProducts(ctx context.Context, in *ClientRequestProducts, opts ...grpc.CallOption) (ProductService_GetVendorProductsClient, error)
 	SetVendorProducts(ctx context.Context, opts ...grpc.CallOption) (ProductService_SetVendorProductsClient, error)
 	ChatVendorSales(ctx context.Context, opts ...grpc.CallOption) (ProductService_ChatVendorSalesClient, error)
+	CreateProduct(ctx context.Context, in *CatalogProduct, opts ...grpc.CallOption) (*CatalogProduct, error)
+	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*CatalogProduct, error)
+	UpdateProduct(ctx context.Context, in *CatalogProduct, opts ...grpc.CallOption) (*CatalogProduct, error)
+	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error)
+	ListVendors(ctx context.Context, in *ListVendorsRequest, opts ...grpc.CallOption) (*ListVendorsResponse, error)
+	CreateVendor(ctx context.Context, in *Vendor, opts ...grpc.CallOption) (*Vendor, error)
+	UpdateVendor(ctx context.Context, in *UpdateVendorRequest, opts ...grpc.CallOption) (*Vendor, error)
+	DeleteVendor(ctx context.Context, in *DeleteVendorRequest, opts ...grpc.CallOption) (*DeleteVendorResponse, error)
+	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
+	SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error)
+	FullTextSearch(ctx context.Context, in *FullTextSearchRequest, opts ...grpc.CallOption) (*FullTextSearchResponse, error)
+	GetShortUrlStats(ctx context.Context, in *GetShortUrlStatsRequest, opts ...grpc.CallOption) (*GetShortUrlStatsResponse, error)
+	IngestProducts(ctx context.Context, opts ...grpc.CallOption) (ProductService_IngestProductsClient, error)
+	MergeDuplicateProducts(ctx context.Context, in *MergeDuplicateProductsRequest, opts ...grpc.CallOption) (*MergeDuplicateProductsResponse, error)
+	ExportCatalog(ctx context.Context, in *ExportCatalogRequest, opts ...grpc.CallOption) (ProductService_ExportCatalogClient, error)
+	RestoreCatalog(ctx context.Context, opts ...grpc.CallOption) (ProductService_RestoreCatalogClient, error)
+	ListRoomParticipants(ctx context.Context, in *ListRoomParticipantsRequest, opts ...grpc.CallOption) (*ListRoomParticipantsResponse, error)
+	MuteChatParticipant(ctx context.Context, in *ModerateChatRequest, opts ...grpc.CallOption) (*ModerationRecord, error)
+	UnmuteChatParticipant(ctx context.Context, in *ModerateChatRequest, opts ...grpc.CallOption) (*ModerationRecord, error)
+	KickChatParticipant(ctx context.Context, in *ModerateChatRequest, opts ...grpc.CallOption) (*ModerationRecord, error)
+	ListModerationLog(ctx context.Context, in *ListModerationLogRequest, opts ...grpc.CallOption) (*ListModerationLogResponse, error)
 }
 
 type productServiceClient struct {
@@ -137,6 +158,265 @@ func (x *productServiceChatVendorSalesClient) Recv() (*ChatMessage, error) {
 	return m, nil
 }
 
+func (c *productServiceClient) CreateProduct(ctx context.Context, in *CatalogProduct, opts ...grpc.CallOption) (*CatalogProduct, error) {
+	out := new(CatalogProduct)
+	err := c.cc.Invoke(ctx, "/products.v1.ProductService/CreateProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*CatalogProduct, error) {
+	out := new(CatalogProduct)
+	err := c.cc.Invoke(ctx, "/products.v1.ProductService/GetProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateProduct(ctx context.Context, in *CatalogProduct, opts ...grpc.CallOption) (*CatalogProduct, error) {
+	out := new(CatalogProduct)
+	err := c.cc.Invoke(ctx, "/products.v1.ProductService/UpdateProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error) {
+	out := new(DeleteProductResponse)
+	err := c.cc.Invoke(ctx, "/products.v1.ProductService/DeleteProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListVendors(ctx context.Context, in *ListVendorsRequest, opts ...grpc.CallOption) (*ListVendorsResponse, error) {
+	out := new(ListVendorsResponse)
+	err := c.cc.Invoke(ctx, "/products.v1.ProductService/ListVendors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateVendor(ctx context.Context, in *Vendor, opts ...grpc.CallOption) (*Vendor, error) {
+	out := new(Vendor)
+	err := c.cc.Invoke(ctx, "/products.v1.ProductService/CreateVendor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateVendor(ctx context.Context, in *UpdateVendorRequest, opts ...grpc.CallOption) (*Vendor, error) {
+	out := new(Vendor)
+	err := c.cc.Invoke(ctx, "/products.v1.ProductService/UpdateVendor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteVendor(ctx context.Context, in *DeleteVendorRequest, opts ...grpc.CallOption) (*DeleteVendorResponse, error) {
+	out := new(DeleteVendorResponse)
+	err := c.cc.Invoke(ctx, "/products.v1.ProductService/DeleteVendor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error) {
+	out := new(ListProductsResponse)
+	err := c.cc.Invoke(ctx, "/products.v1.ProductService/ListProducts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error) {
+	out := new(SearchProductsResponse)
+	err := c.cc.Invoke(ctx, "/products.v1.ProductService/SearchProducts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) FullTextSearch(ctx context.Context, in *FullTextSearchRequest, opts ...grpc.CallOption) (*FullTextSearchResponse, error) {
+	out := new(FullTextSearchResponse)
+	err := c.cc.Invoke(ctx, "/products.v1.ProductService/FullTextSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetShortUrlStats(ctx context.Context, in *GetShortUrlStatsRequest, opts ...grpc.CallOption) (*GetShortUrlStatsResponse, error) {
+	out := new(GetShortUrlStatsResponse)
+	err := c.cc.Invoke(ctx, "/products.v1.ProductService/GetShortUrlStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) IngestProducts(ctx context.Context, opts ...grpc.CallOption) (ProductService_IngestProductsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ProductService_serviceDesc.Streams[3], "/products.v1.ProductService/IngestProducts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &productServiceIngestProductsClient{stream}
+	return x, nil
+}
+
+type ProductService_IngestProductsClient interface {
+	Send(*AdminClientRequestProducts) error
+	Recv() (*IngestResult, error)
+	grpc.ClientStream
+}
+
+type productServiceIngestProductsClient struct {
+	grpc.ClientStream
+}
+
+func (x *productServiceIngestProductsClient) Send(m *AdminClientRequestProducts) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *productServiceIngestProductsClient) Recv() (*IngestResult, error) {
+	m := new(IngestResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *productServiceClient) MergeDuplicateProducts(ctx context.Context, in *MergeDuplicateProductsRequest, opts ...grpc.CallOption) (*MergeDuplicateProductsResponse, error) {
+	out := new(MergeDuplicateProductsResponse)
+	err := c.cc.Invoke(ctx, "/products.v1.ProductService/MergeDuplicateProducts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ExportCatalog(ctx context.Context, in *ExportCatalogRequest, opts ...grpc.CallOption) (ProductService_ExportCatalogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ProductService_serviceDesc.Streams[4], "/products.v1.ProductService/ExportCatalog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &productServiceExportCatalogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ProductService_ExportCatalogClient interface {
+	Recv() (*ExportRecord, error)
+	grpc.ClientStream
+}
+
+type productServiceExportCatalogClient struct {
+	grpc.ClientStream
+}
+
+func (x *productServiceExportCatalogClient) Recv() (*ExportRecord, error) {
+	m := new(ExportRecord)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *productServiceClient) RestoreCatalog(ctx context.Context, opts ...grpc.CallOption) (ProductService_RestoreCatalogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ProductService_serviceDesc.Streams[5], "/products.v1.ProductService/RestoreCatalog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &productServiceRestoreCatalogClient{stream}
+	return x, nil
+}
+
+type ProductService_RestoreCatalogClient interface {
+	Send(*RestoreCatalogRequest) error
+	CloseAndRecv() (*RestoreCatalogResponse, error)
+	grpc.ClientStream
+}
+
+type productServiceRestoreCatalogClient struct {
+	grpc.ClientStream
+}
+
+func (x *productServiceRestoreCatalogClient) Send(m *RestoreCatalogRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *productServiceRestoreCatalogClient) CloseAndRecv() (*RestoreCatalogResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(RestoreCatalogResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *productServiceClient) ListRoomParticipants(ctx context.Context, in *ListRoomParticipantsRequest, opts ...grpc.CallOption) (*ListRoomParticipantsResponse, error) {
+	out := new(ListRoomParticipantsResponse)
+	err := c.cc.Invoke(ctx, "/products.v1.ProductService/ListRoomParticipants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) MuteChatParticipant(ctx context.Context, in *ModerateChatRequest, opts ...grpc.CallOption) (*ModerationRecord, error) {
+	out := new(ModerationRecord)
+	err := c.cc.Invoke(ctx, "/products.v1.ProductService/MuteChatParticipant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UnmuteChatParticipant(ctx context.Context, in *ModerateChatRequest, opts ...grpc.CallOption) (*ModerationRecord, error) {
+	out := new(ModerationRecord)
+	err := c.cc.Invoke(ctx, "/products.v1.ProductService/UnmuteChatParticipant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) KickChatParticipant(ctx context.Context, in *ModerateChatRequest, opts ...grpc.CallOption) (*ModerationRecord, error) {
+	out := new(ModerationRecord)
+	err := c.cc.Invoke(ctx, "/products.v1.ProductService/KickChatParticipant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListModerationLog(ctx context.Context, in *ListModerationLogRequest, opts ...grpc.CallOption) (*ListModerationLogResponse, error) {
+	out := new(ListModerationLogResponse)
+	err := c.cc.Invoke(ctx, "/products.v1.ProductService/ListModerationLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility
@@ -145,6 +425,27 @@ type ProductServiceServer interface {
 	GetVendorProducts(*ClientRequestProducts, ProductService_GetVendorProductsServer) error
 	SetVendorProducts(ProductService_SetVendorProductsServer) error
 	ChatVendorSales(ProductService_ChatVendorSalesServer) error
+	CreateProduct(context.Context, *CatalogProduct) (*CatalogProduct, error)
+	GetProduct(context.Context, *GetProductRequest) (*CatalogProduct, error)
+	UpdateProduct(context.Context, *CatalogProduct) (*CatalogProduct, error)
+	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
+	ListVendors(context.Context, *ListVendorsRequest) (*ListVendorsResponse, error)
+	CreateVendor(context.Context, *Vendor) (*Vendor, error)
+	UpdateVendor(context.Context, *UpdateVendorRequest) (*Vendor, error)
+	DeleteVendor(context.Context, *DeleteVendorRequest) (*DeleteVendorResponse, error)
+	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
+	SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error)
+	FullTextSearch(context.Context, *FullTextSearchRequest) (*FullTextSearchResponse, error)
+	GetShortUrlStats(context.Context, *GetShortUrlStatsRequest) (*GetShortUrlStatsResponse, error)
+	IngestProducts(ProductService_IngestProductsServer) error
+	MergeDuplicateProducts(context.Context, *MergeDuplicateProductsRequest) (*MergeDuplicateProductsResponse, error)
+	ExportCatalog(*ExportCatalogRequest, ProductService_ExportCatalogServer) error
+	RestoreCatalog(ProductService_RestoreCatalogServer) error
+	ListRoomParticipants(context.Context, *ListRoomParticipantsRequest) (*ListRoomParticipantsResponse, error)
+	MuteChatParticipant(context.Context, *ModerateChatRequest) (*ModerationRecord, error)
+	UnmuteChatParticipant(context.Context, *ModerateChatRequest) (*ModerationRecord, error)
+	KickChatParticipant(context.Context, *ModerateChatRequest) (*ModerationRecord, error)
+	ListModerationLog(context.Context, *ListModerationLogRequest) (*ListModerationLogResponse, error)
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -164,6 +465,69 @@ func (UnimplementedProductServiceServer) SetVendorProducts(ProductService_SetVen
 func (UnimplementedProductServiceServer) ChatVendorSales(ProductService_ChatVendorSalesServer) error {
 	return status.Errorf(codes.Unimplemented, "method ChatVendorSales not implemented")
 }
+func (UnimplementedProductServiceServer) CreateProduct(context.Context, *CatalogProduct) (*CatalogProduct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
+}
+func (UnimplementedProductServiceServer) GetProduct(context.Context, *GetProductRequest) (*CatalogProduct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateProduct(context.Context, *CatalogProduct) (*CatalogProduct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+}
+func (UnimplementedProductServiceServer) ListVendors(context.Context, *ListVendorsRequest) (*ListVendorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVendors not implemented")
+}
+func (UnimplementedProductServiceServer) CreateVendor(context.Context, *Vendor) (*Vendor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVendor not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateVendor(context.Context, *UpdateVendorRequest) (*Vendor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVendor not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteVendor(context.Context, *DeleteVendorRequest) (*DeleteVendorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVendor not implemented")
+}
+func (UnimplementedProductServiceServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
+}
+func (UnimplementedProductServiceServer) SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProducts not implemented")
+}
+func (UnimplementedProductServiceServer) FullTextSearch(context.Context, *FullTextSearchRequest) (*FullTextSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FullTextSearch not implemented")
+}
+func (UnimplementedProductServiceServer) GetShortUrlStats(context.Context, *GetShortUrlStatsRequest) (*GetShortUrlStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShortUrlStats not implemented")
+}
+func (UnimplementedProductServiceServer) IngestProducts(ProductService_IngestProductsServer) error {
+	return status.Errorf(codes.Unimplemented, "method IngestProducts not implemented")
+}
+func (UnimplementedProductServiceServer) MergeDuplicateProducts(context.Context, *MergeDuplicateProductsRequest) (*MergeDuplicateProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeDuplicateProducts not implemented")
+}
+func (UnimplementedProductServiceServer) ExportCatalog(*ExportCatalogRequest, ProductService_ExportCatalogServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportCatalog not implemented")
+}
+func (UnimplementedProductServiceServer) RestoreCatalog(ProductService_RestoreCatalogServer) error {
+	return status.Errorf(codes.Unimplemented, "method RestoreCatalog not implemented")
+}
+func (UnimplementedProductServiceServer) ListRoomParticipants(context.Context, *ListRoomParticipantsRequest) (*ListRoomParticipantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoomParticipants not implemented")
+}
+func (UnimplementedProductServiceServer) MuteChatParticipant(context.Context, *ModerateChatRequest) (*ModerationRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteChatParticipant not implemented")
+}
+func (UnimplementedProductServiceServer) UnmuteChatParticipant(context.Context, *ModerateChatRequest) (*ModerationRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteChatParticipant not implemented")
+}
+func (UnimplementedProductServiceServer) KickChatParticipant(context.Context, *ModerateChatRequest) (*ModerationRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickChatParticipant not implemented")
+}
+func (UnimplementedProductServiceServer) ListModerationLog(context.Context, *ListModerationLogRequest) (*ListModerationLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerationLog not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 
 // UnsafeProductServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -268,30 +632,515 @@ func (x *productServiceChatVendorSalesServer) Recv() (*ChatMessage, error) {
 	return m, nil
 }
 
-var _ProductService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "products.v1.ProductService",
-	HandlerType: (*ProductServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetVendorProductTypes",
-			Handler:    _ProductService_GetVendorProductTypes_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "GetVendorProducts",
-			Handler:       _ProductService_GetVendorProducts_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "SetVendorProducts",
-			Handler:       _ProductService_SetVendorProducts_Handler,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "ChatVendorSales",
-			Handler:       _ProductService_ChatVendorSales_Handler,
-			ServerStreams: true,
+func _ProductService_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogProduct)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/products.v1.ProductService/CreateProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateProduct(ctx, req.(*CatalogProduct))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/products.v1.ProductService/GetProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProduct(ctx, req.(*GetProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogProduct)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/products.v1.ProductService/UpdateProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateProduct(ctx, req.(*CatalogProduct))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/products.v1.ProductService/DeleteProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListVendors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVendorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListVendors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/products.v1.ProductService/ListVendors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListVendors(ctx, req.(*ListVendorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateVendor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Vendor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateVendor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/products.v1.ProductService/CreateVendor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateVendor(ctx, req.(*Vendor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateVendor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVendorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateVendor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/products.v1.ProductService/UpdateVendor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateVendor(ctx, req.(*UpdateVendorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteVendor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVendorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteVendor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/products.v1.ProductService/DeleteVendor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteVendor(ctx, req.(*DeleteVendorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/products.v1.ProductService/ListProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListProducts(ctx, req.(*ListProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SearchProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/products.v1.ProductService/SearchProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SearchProducts(ctx, req.(*SearchProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_FullTextSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FullTextSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).FullTextSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/products.v1.ProductService/FullTextSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).FullTextSearch(ctx, req.(*FullTextSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetShortUrlStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShortUrlStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetShortUrlStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/products.v1.ProductService/GetShortUrlStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetShortUrlStats(ctx, req.(*GetShortUrlStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_IngestProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ProductServiceServer).IngestProducts(&productServiceIngestProductsServer{stream})
+}
+
+type ProductService_IngestProductsServer interface {
+	Send(*IngestResult) error
+	Recv() (*AdminClientRequestProducts, error)
+	grpc.ServerStream
+}
+
+type productServiceIngestProductsServer struct {
+	grpc.ServerStream
+}
+
+func (x *productServiceIngestProductsServer) Send(m *IngestResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *productServiceIngestProductsServer) Recv() (*AdminClientRequestProducts, error) {
+	m := new(AdminClientRequestProducts)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ProductService_MergeDuplicateProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeDuplicateProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).MergeDuplicateProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/products.v1.ProductService/MergeDuplicateProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).MergeDuplicateProducts(ctx, req.(*MergeDuplicateProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ExportCatalog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportCatalogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProductServiceServer).ExportCatalog(m, &productServiceExportCatalogServer{stream})
+}
+
+type ProductService_ExportCatalogServer interface {
+	Send(*ExportRecord) error
+	grpc.ServerStream
+}
+
+type productServiceExportCatalogServer struct {
+	grpc.ServerStream
+}
+
+func (x *productServiceExportCatalogServer) Send(m *ExportRecord) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ProductService_RestoreCatalog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ProductServiceServer).RestoreCatalog(&productServiceRestoreCatalogServer{stream})
+}
+
+type ProductService_RestoreCatalogServer interface {
+	SendAndClose(*RestoreCatalogResponse) error
+	Recv() (*RestoreCatalogRequest, error)
+	grpc.ServerStream
+}
+
+type productServiceRestoreCatalogServer struct {
+	grpc.ServerStream
+}
+
+func (x *productServiceRestoreCatalogServer) SendAndClose(m *RestoreCatalogResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *productServiceRestoreCatalogServer) Recv() (*RestoreCatalogRequest, error) {
+	m := new(RestoreCatalogRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ProductService_ListRoomParticipants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoomParticipantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListRoomParticipants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/products.v1.ProductService/ListRoomParticipants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListRoomParticipants(ctx, req.(*ListRoomParticipantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_MuteChatParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).MuteChatParticipant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/products.v1.ProductService/MuteChatParticipant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).MuteChatParticipant(ctx, req.(*ModerateChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UnmuteChatParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UnmuteChatParticipant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/products.v1.ProductService/UnmuteChatParticipant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UnmuteChatParticipant(ctx, req.(*ModerateChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_KickChatParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).KickChatParticipant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/products.v1.ProductService/KickChatParticipant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).KickChatParticipant(ctx, req.(*ModerateChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListModerationLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListModerationLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/products.v1.ProductService/ListModerationLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListModerationLog(ctx, req.(*ListModerationLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ProductService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "products.v1.ProductService",
+	HandlerType: (*ProductServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetVendorProductTypes",
+			Handler:    _ProductService_GetVendorProductTypes_Handler,
+		},
+		{
+			MethodName: "CreateProduct",
+			Handler:    _ProductService_CreateProduct_Handler,
+		},
+		{
+			MethodName: "GetProduct",
+			Handler:    _ProductService_GetProduct_Handler,
+		},
+		{
+			MethodName: "UpdateProduct",
+			Handler:    _ProductService_UpdateProduct_Handler,
+		},
+		{
+			MethodName: "DeleteProduct",
+			Handler:    _ProductService_DeleteProduct_Handler,
+		},
+		{
+			MethodName: "ListVendors",
+			Handler:    _ProductService_ListVendors_Handler,
+		},
+		{
+			MethodName: "CreateVendor",
+			Handler:    _ProductService_CreateVendor_Handler,
+		},
+		{
+			MethodName: "UpdateVendor",
+			Handler:    _ProductService_UpdateVendor_Handler,
+		},
+		{
+			MethodName: "DeleteVendor",
+			Handler:    _ProductService_DeleteVendor_Handler,
+		},
+		{
+			MethodName: "ListProducts",
+			Handler:    _ProductService_ListProducts_Handler,
+		},
+		{
+			MethodName: "SearchProducts",
+			Handler:    _ProductService_SearchProducts_Handler,
+		},
+		{
+			MethodName: "FullTextSearch",
+			Handler:    _ProductService_FullTextSearch_Handler,
+		},
+		{
+			MethodName: "GetShortUrlStats",
+			Handler:    _ProductService_GetShortUrlStats_Handler,
+		},
+		{
+			MethodName: "MergeDuplicateProducts",
+			Handler:    _ProductService_MergeDuplicateProducts_Handler,
+		},
+		{
+			MethodName: "ListRoomParticipants",
+			Handler:    _ProductService_ListRoomParticipants_Handler,
+		},
+		{
+			MethodName: "MuteChatParticipant",
+			Handler:    _ProductService_MuteChatParticipant_Handler,
+		},
+		{
+			MethodName: "UnmuteChatParticipant",
+			Handler:    _ProductService_UnmuteChatParticipant_Handler,
+		},
+		{
+			MethodName: "KickChatParticipant",
+			Handler:    _ProductService_KickChatParticipant_Handler,
+		},
+		{
+			MethodName: "ListModerationLog",
+			Handler:    _ProductService_ListModerationLog_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetVendorProducts",
+			Handler:       _ProductService_GetVendorProducts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SetVendorProducts",
+			Handler:       _ProductService_SetVendorProducts_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ChatVendorSales",
+			Handler:       _ProductService_ChatVendorSales_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "IngestProducts",
+			Handler:       _ProductService_IngestProducts_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportCatalog",
+			Handler:       _ProductService_ExportCatalog_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RestoreCatalog",
+			Handler:       _ProductService_RestoreCatalog_Handler,
 			ClientStreams: true,
 		},
 	},